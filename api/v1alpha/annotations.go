@@ -4,4 +4,9 @@ const (
 	AnnotationNamespace = "compute.datumapis.com"
 
 	SSHKeysAnnotation = AnnotationNamespace + "/ssh-keys"
+
+	// TemplateRevisionAnnotation is set on deployments to the workload's
+	// revision number for the deployment's instance template. Revisions created
+	// for the deployment's instance template use this revision number.
+	TemplateRevisionAnnotation = AnnotationNamespace + "/template-revision"
 )
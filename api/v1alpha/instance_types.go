@@ -3,6 +3,7 @@ package v1alpha
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
 )
@@ -24,9 +25,11 @@ type InstanceSpec struct {
 	// Virtual Machine.
 	//
 	// +kubebuilder:validation:Optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=name
-	Volumes []InstanceVolume `json:"volumes,omitempty"`
+	Volumes []InstanceVolume `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// The location which the instance has been scheduled to
 	//
@@ -37,8 +40,26 @@ type InstanceSpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	Controller *InstanceController `json:"controller,omitempty"`
+
+	// The desired power state of the instance. Stopped instances retain their
+	// volumes, and are started again when the power state is set to Running.
+	// Defaults to Running.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Running;Stopped
+	PowerState InstancePowerState `json:"powerState,omitempty"`
 }
 
+type InstancePowerState string
+
+const (
+	// RunningInstancePowerState indicates that the instance should be running.
+	RunningInstancePowerState InstancePowerState = "Running"
+
+	// StoppedInstancePowerState indicates that the instance should be stopped.
+	StoppedInstancePowerState InstancePowerState = "Stopped"
+)
+
 type InstanceController struct {
 	// TemplateHash is the hash of the instance template applied for this instance.
 	//
@@ -52,6 +73,20 @@ type InstanceController struct {
 	// +listType=map
 	// +listMapKey=name
 	SchedulingGates []SchedulingGate `json:"schedulingGates,omitempty"`
+
+	// RestartedAt is the time that the instance was last requested to be
+	// restarted. The instance's infrastructure provider will restart the
+	// instance when this value changes.
+	//
+	// +kubebuilder:validation:Optional
+	RestartedAt *metav1.Time `json:"restartedAt,omitempty"`
+
+	// RestartRequestedAt is the time of the most recent restart request which
+	// has been handled for the instance, either by restarting the instance or
+	// by creating it while the request was in effect.
+	//
+	// +kubebuilder:validation:Optional
+	RestartRequestedAt *metav1.Time `json:"restartRequestedAt,omitempty"`
 }
 
 type SchedulingGate struct {
@@ -85,9 +120,27 @@ type SandboxRuntime struct {
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	Containers []SandboxContainer `json:"containers,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// A list of containers which are started in order before the sandbox's
+	// containers. Each init container must run to completion before the next
+	// one is started, unless it is a sidecar container.
+	//
+	// Init containers with a restart policy of Always are sidecar containers.
+	// Sidecar containers are started before the init containers which follow
+	// them, run for the lifetime of the sandbox, and are stopped after the
+	// sandbox's containers.
+	//
+	// +kubebuilder:validation:Optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=name
-	Containers []SandboxContainer `json:"containers,omitempty"`
+	InitContainers []SandboxContainer `json:"initContainers,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// An optional list of secrets in the same namespace to use for pulling images
 	// used by the instance.
@@ -107,6 +160,31 @@ type SandboxContainer struct {
 	// +kubebuilder:validation:Required
 	Image string `json:"image"`
 
+	// The entrypoint of the container. The command is not executed within a
+	// shell. The image's entrypoint is used if not provided.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Command []string `json:"command,omitempty"`
+
+	// Arguments to the entrypoint. The image's command is used if not
+	// provided.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+
+	// The container's working directory. The image's working directory is used
+	// if not provided.
+	//
+	// +kubebuilder:validation:Optional
+	WorkingDir string `json:"workingDir,omitempty"`
+
+	// Security options the container should be run with.
+	//
+	// +kubebuilder:validation:Optional
+	SecurityContext *ContainerSecurityContext `json:"securityContext,omitempty"`
+
 	// List of environment variables to set in the container.
 	//
 	// +kubebuilder:validation:Optional
@@ -114,9 +192,7 @@ type SandboxContainer struct {
 	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=name
-	// TODO(jreese) can't use corev1.EnvVar due to EnvVarSource being k8s specific,
-	// so replicate the structure here too.
-	Env []corev1.EnvVar `json:"env,omitempty"`
+	Env []EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// The resource requirements for the container, such as CPU, memory, and GPUs.
 	//
@@ -126,14 +202,356 @@ type SandboxContainer struct {
 	// A list of volumes to attach to the container.
 	//
 	// +kubebuilder:validation:Optional
-	VolumeAttachments []VolumeAttachment `json:"volumeAttachments,omitempty"`
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	VolumeAttachments []VolumeAttachment `json:"volumeAttachments,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// A list of named ports for the container.
 	//
 	// +kubebuilder:validation:Optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=name
-	Ports []NamedPort `json:"ports,omitempty"`
+	Ports []NamedPort `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Periodic probe of container readiness. The instance will not be considered
+	// ready until the readiness probes of all of its containers succeed.
+	//
+	// +kubebuilder:validation:Optional
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// Periodic probe of container liveness. The container will be restarted if
+	// the probe fails.
+	//
+	// +kubebuilder:validation:Optional
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Indicates that the container has successfully started. Readiness and
+	// liveness probes are not executed until the startup probe succeeds. The
+	// container will be restarted if the probe fails.
+	//
+	// +kubebuilder:validation:Optional
+	StartupProbe *Probe `json:"startupProbe,omitempty"`
+
+	// The restart policy of an init container. Init containers with a restart
+	// policy of Always are sidecar containers, which keep running alongside the
+	// sandbox's containers. May only be provided for init containers.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Always
+	RestartPolicy *ContainerRestartPolicy `json:"restartPolicy,omitempty"`
+}
+
+// ContainerRestartPolicy is the restart policy of an init container.
+type ContainerRestartPolicy string
+
+const (
+	// AlwaysContainerRestartPolicy runs the init container as a sidecar
+	// container, restarting it whenever it exits.
+	AlwaysContainerRestartPolicy ContainerRestartPolicy = "Always"
+)
+
+// EnvVar represents an environment variable present in a container.
+type EnvVar struct {
+	// The name of the environment variable.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The value of the environment variable. May not be provided when valueFrom
+	// is provided.
+	//
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+
+	// The source of the environment variable's value. May not be provided when
+	// value is provided.
+	//
+	// +kubebuilder:validation:Optional
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// EnvVarSource represents a source for the value of an environment variable.
+// Exactly one source must be provided.
+type EnvVarSource struct {
+	// Selects a key of a secret in the workload's namespace.
+	//
+	// +kubebuilder:validation:Optional
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// Selects a key of a config map in the workload's namespace.
+	//
+	// +kubebuilder:validation:Optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// Selects a field of the instance the container is running in.
+	//
+	// +kubebuilder:validation:Optional
+	InstanceFieldRef *InstanceFieldSelector `json:"instanceFieldRef,omitempty"`
+}
+
+// SecretKeySelector selects a key of a secret in the same namespace as the
+// entity defining the reference.
+type SecretKeySelector struct {
+	// The name of the secret.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The key of the secret to select.
+	//
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Whether the secret or its key may be absent. When false or unset, the
+	// container will not start until the key is available.
+	//
+	// +kubebuilder:validation:Optional
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a config map in the same namespace as
+// the entity defining the reference.
+type ConfigMapKeySelector struct {
+	// The name of the config map.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The key of the config map to select.
+	//
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Whether the config map or its key may be absent. When false or unset, the
+	// container will not start until the key is available.
+	//
+	// +kubebuilder:validation:Optional
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// InstanceFieldSelector selects a field of an instance.
+type InstanceFieldSelector struct {
+	// The path of the field to select.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=metadata.name;metadata.namespace;metadata.ordinal;spec.location.name;spec.location.cityCode;status.networkInterfaces.networkIP
+	FieldPath InstanceFieldPath `json:"fieldPath"`
+
+	// The index of the network interface to select the IP address of. Only used
+	// when the field path is status.networkInterfaces.networkIP. Defaults to 0.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	NetworkInterfaceIndex *int32 `json:"networkInterfaceIndex,omitempty"`
+}
+
+// InstanceFieldPath is the path of a field which may be selected from an
+// instance.
+type InstanceFieldPath string
+
+const (
+	// InstanceNameFieldPath selects the name of the instance.
+	InstanceNameFieldPath InstanceFieldPath = "metadata.name"
+
+	// InstanceNamespaceFieldPath selects the namespace of the instance.
+	InstanceNamespaceFieldPath InstanceFieldPath = "metadata.namespace"
+
+	// InstanceOrdinalFieldPath selects the ordinal of the instance within its
+	// workload deployment.
+	InstanceOrdinalFieldPath InstanceFieldPath = "metadata.ordinal"
+
+	// InstanceLocationFieldPath selects the name of the location the instance
+	// is running in.
+	InstanceLocationFieldPath InstanceFieldPath = "spec.location.name"
+
+	// InstanceCityCodeFieldPath selects the city code of the location the
+	// instance is running in.
+	InstanceCityCodeFieldPath InstanceFieldPath = "spec.location.cityCode"
+
+	// InstanceNetworkIPFieldPath selects the network IP address assigned to one
+	// of the instance's network interfaces.
+	InstanceNetworkIPFieldPath InstanceFieldPath = "status.networkInterfaces.networkIP"
+)
+
+// ContainerSecurityContext holds the security options a container should be
+// run with. Only options which are supported across all infrastructure
+// providers are available.
+type ContainerSecurityContext struct {
+	// The UID to run the entrypoint of the container process. Defaults to the
+	// user specified in the image.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+
+	// The GID to run the entrypoint of the container process. Defaults to the
+	// group specified in the image.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RunAsGroup *int64 `json:"runAsGroup,omitempty"`
+
+	// Indicates that the container must run as a non-root user. The container
+	// will fail to start if the image would run it as UID 0.
+	//
+	// +kubebuilder:validation:Optional
+	RunAsNonRoot *bool `json:"runAsNonRoot,omitempty"`
+
+	// Whether the container has a read-only root filesystem. Volumes attached
+	// to the container remain writable unless otherwise configured.
+	//
+	// +kubebuilder:validation:Optional
+	ReadOnlyRootFilesystem *bool `json:"readOnlyRootFilesystem,omitempty"`
+
+	// The capabilities to add to or drop from the container's default set of
+	// capabilities.
+	//
+	// +kubebuilder:validation:Optional
+	Capabilities *Capabilities `json:"capabilities,omitempty"`
+}
+
+// Capability represents a POSIX capability, such as NET_BIND_SERVICE.
+type Capability string
+
+// Capabilities adjusts the POSIX capabilities of a running container.
+type Capabilities struct {
+	// Capabilities to add. Only a limited set of capabilities may be added.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Add []Capability `json:"add,omitempty"`
+
+	// Capabilities to drop. Use ALL to drop all capabilities.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Drop []Capability `json:"drop,omitempty"`
+}
+
+// Probe describes a health check to be performed against a container or
+// virtual machine to determine whether it is alive or ready to receive traffic.
+type Probe struct {
+	// The action taken to determine the health of the container or virtual
+	// machine. Exactly one handler must be provided.
+	ProbeHandler `json:",inline"`
+
+	// Number of seconds after the container or virtual machine has started
+	// before probes are initiated.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// Number of seconds after which the probe times out. Defaults to 1 second.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// How often, in seconds, to perform the probe. Defaults to 10 seconds.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// Minimum consecutive successes for the probe to be considered successful
+	// after having failed. Defaults to 1. Must be 1 for liveness and startup
+	// probes.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+
+	// Minimum consecutive failures for the probe to be considered failed after
+	// having succeeded. Defaults to 3.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// ProbeHandler defines a specific action that should be taken in a probe.
+type ProbeHandler struct {
+	// Executes a command inside the container. Not supported for virtual
+	// machines.
+	//
+	// +kubebuilder:validation:Optional
+	Exec *ExecAction `json:"exec,omitempty"`
+
+	// Performs an HTTP GET request.
+	//
+	// +kubebuilder:validation:Optional
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+
+	// Opens a TCP connection.
+	//
+	// +kubebuilder:validation:Optional
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"`
+}
+
+type ExecAction struct {
+	// The command to execute. The command is not run in a shell, and an exit
+	// status of 0 is treated as healthy.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+}
+
+type HTTPGetAction struct {
+	// The path to request.
+	//
+	// +kubebuilder:validation:Optional
+	Path string `json:"path,omitempty"`
+
+	// The number or name of the port to request. Names must refer to a named
+	// port of the container or virtual machine.
+	//
+	// +kubebuilder:validation:Required
+	Port intstr.IntOrString `json:"port"`
+
+	// The scheme to use for the request. Defaults to HTTP.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	Scheme URIScheme `json:"scheme,omitempty"`
+
+	// Custom headers to set in the request.
+	//
+	// +kubebuilder:validation:Optional
+	HTTPHeaders []HTTPHeader `json:"httpHeaders,omitempty"`
+}
+
+type URIScheme string
+
+const (
+	// URISchemeHTTP indicates that HTTP should be used.
+	URISchemeHTTP URIScheme = "HTTP"
+
+	// URISchemeHTTPS indicates that HTTPS should be used.
+	URISchemeHTTPS URIScheme = "HTTPS"
+)
+
+type HTTPHeader struct {
+	// The header field name.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The header field value.
+	//
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+}
+
+type TCPSocketAction struct {
+	// The number or name of the port to connect to. Names must refer to a named
+	// port of the container or virtual machine.
+	//
+	// +kubebuilder:validation:Required
+	Port intstr.IntOrString `json:"port"`
 }
 
 type ContainerResourceRequirements struct {
@@ -173,14 +591,37 @@ type VirtualMachineRuntime struct {
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	VolumeAttachments []VolumeAttachment `json:"volumeAttachments,omitempty"`
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	VolumeAttachments []VolumeAttachment `json:"volumeAttachments,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// A list of named ports for the virtual machine.
 	//
 	// +kubebuilder:validation:Optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=name
-	Ports []NamedPort `json:"ports,omitempty"`
+	Ports []NamedPort `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Periodic probe of virtual machine readiness. The instance will not be
+	// considered ready until the probe succeeds.
+	//
+	// +kubebuilder:validation:Optional
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// Periodic probe of virtual machine liveness. The virtual machine will be
+	// restarted if the probe fails.
+	//
+	// +kubebuilder:validation:Optional
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Indicates that the virtual machine has successfully booted. Readiness and
+	// liveness probes are not executed until the startup probe succeeds. The
+	// virtual machine will be restarted if the probe fails.
+	//
+	// +kubebuilder:validation:Optional
+	StartupProbe *Probe `json:"startupProbe,omitempty"`
 }
 
 type VolumeAttachment struct {
@@ -208,11 +649,17 @@ type InstanceRuntimeResources struct {
 	// instance families that support customization. Instance types which support
 	// customization will appear in the form `<project>/<instanceFamily>-custom`.
 	//
+	// The instance type must be declared by an InstanceType. See
+	// InstanceTypeName for how instance types are mapped to InstanceTypes.
+	//
 	// +kubebuilder:validation:Required
 	InstanceType string `json:"instanceType"`
 
 	// Describes adjustments to the resources defined by the instance type.
 	//
+	// Instance types which support customization require `cpu` and `memory`
+	// requests within the ranges declared by the InstanceType.
+	//
 	// +kubebuilder:validation:Optional
 	Requests corev1.ResourceList `json:"requests,omitempty"`
 }
@@ -371,13 +818,97 @@ type InstanceStatus struct {
 	//
 	// +kubebuilder:validation:Optional
 	Controller *InstanceControllerStatus `json:"controller,omitempty"`
+
+	// The status of each of the sandbox's init containers, in the order they are
+	// defined. Reported by the instance's infrastructure provider.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	InitContainers []InitContainerStatus `json:"initContainers,omitempty"`
 }
 
+type InitContainerStatus struct {
+	// The name of the init container.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The current state of the init container.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Waiting;Running;Completed;Failed
+	State InitContainerState `json:"state"`
+
+	// Whether a sidecar container has passed its readiness probe. Always false
+	// for init containers which are not sidecar containers.
+	//
+	// +kubebuilder:validation:Optional
+	Ready bool `json:"ready,omitempty"`
+
+	// The number of times the init container has been restarted.
+	//
+	// +kubebuilder:validation:Optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// The exit code of the init container's most recent termination.
+	//
+	// +kubebuilder:validation:Optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// A brief CamelCase reason for the init container's current state.
+	//
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message with details about the init container's current
+	// state.
+	//
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// The time the init container was last started.
+	//
+	// +kubebuilder:validation:Optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// The time the init container last finished.
+	//
+	// +kubebuilder:validation:Optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+}
+
+// InitContainerState is the state of an init container.
+type InitContainerState string
+
+const (
+	// WaitingInitContainerState indicates that the init container has not yet
+	// been started.
+	WaitingInitContainerState InitContainerState = "Waiting"
+
+	// RunningInitContainerState indicates that the init container is running.
+	RunningInitContainerState InitContainerState = "Running"
+
+	// CompletedInitContainerState indicates that the init container has run to
+	// completion successfully.
+	CompletedInitContainerState InitContainerState = "Completed"
+
+	// FailedInitContainerState indicates that the init container exited with a
+	// failure.
+	FailedInitContainerState InitContainerState = "Failed"
+)
+
 type InstanceControllerStatus struct {
 	// ObservedTemplateHash is the hash of the instance template applied for this instance.
 	//
 	// +kubebuilder:validation:Required
 	ObservedTemplateHash string `json:"observedTemplateHash"`
+
+	// ObservedRestartedAt is the restart request most recently completed by the
+	// instance's infrastructure provider.
+	//
+	// +kubebuilder:validation:Optional
+	ObservedRestartedAt *metav1.Time `json:"observedRestartedAt,omitempty"`
 }
 
 const (
@@ -389,6 +920,11 @@ const (
 
 	// InstanceProgrammed indicates that the instance has been programmed
 	InstanceProgrammed = "Programmed"
+
+	// InstanceProbesReady indicates that the readiness probes of the instance's
+	// containers or virtual machine have succeeded. Only reported for instances
+	// which define readiness or startup probes.
+	InstanceProbesReady = "ProbesReady"
 )
 
 const (
@@ -398,6 +934,14 @@ const (
 	// InstanceReadyReasonRunning indicates that the instance is running
 	InstanceReadyReasonRunning = "Running"
 
+	// InstanceReadyReasonInitializing indicates that the instance's init
+	// containers have not yet completed.
+	InstanceReadyReasonInitializing = "Initializing"
+
+	// InstanceReadyReasonInitContainerFailed indicates that one of the
+	// instance's init containers has failed.
+	InstanceReadyReasonInitContainerFailed = "InitContainerFailed"
+
 	// InstanceRunningReasonStopped indicates that the instance is stopped
 	InstanceRunningReasonStopped = "Stopped"
 
@@ -410,6 +954,10 @@ const (
 	// InstanceRunningReasonRunning indicates that the instance is running
 	InstanceRunningReasonRunning = "Running"
 
+	// InstanceProbesReadyReasonProbesNotReady indicates that one or more
+	// readiness probes have not succeeded
+	InstanceProbesReadyReasonProbesNotReady = "ProbesNotReady"
+
 	// InstanceProgrammedReasonPendingProgramming indicates that the instance has not been programmed
 	InstanceProgrammedReasonPendingProgramming = "PendingProgramming"
 
@@ -0,0 +1,47 @@
+package v1alpha
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// InstanceRevision is an immutable snapshot of an instance template applied
+// by a WorkloadDeployment. A revision is created for each distinct instance
+// template, and may be used to roll a workload back to a previous template.
+//
+// Inspired by ControllerRevision.
+//
+// +kubebuilder:printcolumn:name="Revision",type=integer,JSONPath=`.revision`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type InstanceRevision struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The instance template captured by the revision.
+	//
+	// +kubebuilder:validation:Required
+	Template InstanceTemplateSpec `json:"template"`
+
+	// The revision number of the template. Revision numbers are assigned by the
+	// workload, and increase each time its instance template changes, including
+	// when a previous template is applied again. The revisions of each of the
+	// workload's deployments share the same revision numbers.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	Revision int64 `json:"revision"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceRevisionList contains a list of InstanceRevision
+type InstanceRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceRevision `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InstanceRevision{}, &InstanceRevisionList{})
+}
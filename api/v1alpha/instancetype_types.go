@@ -0,0 +1,124 @@
+package v1alpha
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+)
+
+// InstanceTypeSpec defines the desired state of InstanceType
+type InstanceTypeSpec struct {
+	// The resources provided to instances of the instance type, such as `cpu`
+	// and `memory`.
+	//
+	// For instance types which support customization, the `cpu` and `memory`
+	// resources are provided by the instance's requests, and any other
+	// resources listed here are provided in addition to them.
+	//
+	// +kubebuilder:validation:Optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+
+	// The runtimes which instances of the instance type may use.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Runtimes []InstanceRuntimeType `json:"runtimes"`
+
+	// The ranges of resources which may be requested by instances of the
+	// instance type. Instance types which support customization should be
+	// named in the form `<instanceFamily>-custom`.
+	//
+	// +kubebuilder:validation:Optional
+	Custom *CustomInstanceTypeResources `json:"custom,omitempty"`
+
+	// The locations which offer the instance type. Deployments will only be
+	// scheduled to locations which offer their instance type.
+	//
+	// The instance type is offered by all locations if none are provided.
+	//
+	// +kubebuilder:validation:Optional
+	Locations []networkingv1alpha.LocationReference `json:"locations,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Sandbox;VirtualMachine
+type InstanceRuntimeType string
+
+const (
+	SandboxInstanceRuntimeType        InstanceRuntimeType = "Sandbox"
+	VirtualMachineInstanceRuntimeType InstanceRuntimeType = "VirtualMachine"
+)
+
+// CustomInstanceTypeResources defines the ranges of resources which may be
+// requested by instances of an instance type which supports customization.
+type CustomInstanceTypeResources struct {
+	// The range of CPU which may be requested.
+	//
+	// +kubebuilder:validation:Required
+	CPU ResourceRange `json:"cpu"`
+
+	// The range of memory which may be requested.
+	//
+	// +kubebuilder:validation:Required
+	Memory ResourceRange `json:"memory"`
+}
+
+// ResourceRange defines the quantities of a resource which may be requested.
+type ResourceRange struct {
+	// The minimum quantity which may be requested.
+	//
+	// +kubebuilder:validation:Required
+	Min resource.Quantity `json:"min"`
+
+	// The maximum quantity which may be requested.
+	//
+	// +kubebuilder:validation:Required
+	Max resource.Quantity `json:"max"`
+
+	// Requested quantities must be a multiple of the increment, if provided.
+	//
+	// +kubebuilder:validation:Optional
+	Increment *resource.Quantity `json:"increment,omitempty"`
+}
+
+// InstanceTypeName returns the name of the InstanceType which declares the
+// provided instance type. The project and name of the instance type are joined
+// with a period, for example `datumcloud/d1-standard-2` is declared by the
+// InstanceType named `datumcloud.d1-standard-2`.
+func InstanceTypeName(instanceType string) string {
+	return strings.ReplaceAll(instanceType, "/", ".")
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// InstanceType declares the resources and runtimes provided by an instance
+// type, and the locations which offer it. Instances may only use instance
+// types which have been declared.
+//
+// +kubebuilder:printcolumn:name="CPU",type=string,JSONPath=`.spec.resources.cpu`
+// +kubebuilder:printcolumn:name="Memory",type=string,JSONPath=`.spec.resources.memory`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type InstanceType struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec InstanceTypeSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceTypeList contains a list of InstanceType
+type InstanceTypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceType `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InstanceType{}, &InstanceTypeList{})
+}
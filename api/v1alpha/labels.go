@@ -7,4 +7,16 @@ const (
 	WorkloadDeploymentUIDLabel = LabelNamespace + "/workload-deployment-uid"
 
 	InstanceIndexLabel = LabelNamespace + "/instance-index"
+
+	InstanceTemplateHashLabel = LabelNamespace + "/instance-template-hash"
+
+	// TemplateOverriddenLabel is set to "true" on deployments, and the instance
+	// revisions they create, when placement template overrides have been
+	// applied to the workload's instance template.
+	TemplateOverriddenLabel = LabelNamespace + "/template-overridden"
+
+	// LocationUnschedulableAnnotation may be set to "true" on a Location to
+	// prevent deployments from being scheduled to it. Deployments which have
+	// already been scheduled to the location will be moved to other locations.
+	LocationUnschedulableAnnotation = LabelNamespace + "/unschedulable"
 )
@@ -0,0 +1,72 @@
+package v1alpha
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+)
+
+// LocationCapacitySpec defines the desired state of LocationCapacity
+type LocationCapacitySpec struct {
+	// The location which capacity is being declared for.
+	//
+	// +kubebuilder:validation:Required
+	LocationRef networkingv1alpha.LocationReference `json:"locationRef"`
+
+	// The number of instances of each instance type which may be scheduled to
+	// the location.
+	//
+	// Instance types which are not listed are not constrained. When multiple
+	// LocationCapacities reference the same location, the limits for each
+	// instance type are summed.
+	//
+	// +kubebuilder:validation:Required
+	// +listType=map
+	// +listMapKey=instanceType
+	Instances []InstanceTypeCapacity `json:"instances"`
+}
+
+// InstanceTypeCapacity defines the number of instances of an instance type
+// which may be scheduled to a location.
+type InstanceTypeCapacity struct {
+	// The instance type, for example `datumcloud/d1-standard-2`.
+	//
+	// +kubebuilder:validation:Required
+	InstanceType string `json:"instanceType"`
+
+	// The maximum number of instances of the instance type.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Limit int32 `json:"limit"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocationCapacity declares the capacity available to workloads in a location.
+// The scheduler will not schedule a deployment to a location if the desired
+// instances of all deployments in the location would exceed its capacity.
+// Deployments which may be autoscaled reserve their maximum number of replicas.
+//
+// +kubebuilder:printcolumn:name="Location Namespace",type=string,JSONPath=`.spec.locationRef.namespace`
+// +kubebuilder:printcolumn:name="Location Name",type=string,JSONPath=`.spec.locationRef.name`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type LocationCapacity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LocationCapacitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocationCapacityList contains a list of LocationCapacity
+type LocationCapacityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocationCapacity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocationCapacity{}, &LocationCapacityList{})
+}
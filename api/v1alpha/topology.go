@@ -0,0 +1,9 @@
+package v1alpha
+
+const (
+	TopologyNamespace = "topology.datum.net"
+
+	// CityCodeTopologyKey is the key in a location's topology which identifies
+	// the city that the location resides in.
+	CityCodeTopologyKey = TopologyNamespace + "/city-code"
+)
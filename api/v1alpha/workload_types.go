@@ -4,9 +4,10 @@ import (
 	k8scorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
-	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 // WorkloadSpec defines the desired state of Workload
@@ -25,20 +26,255 @@ type WorkloadSpec struct {
 
 	// Workload specific gateway
 	//
+	// When provided, a Gateway will be created for the workload along with
+	// TCPRoutes that direct traffic to named ports on the workload's instances.
+	//
 	// TODO(jreese) make plural?
 	//
 	// +kubebuilder:validation:Optional
-	// Gateway *WorkloadGateway `json:"gateway,omitempty"`
+	Gateway *WorkloadGateway `json:"gateway,omitempty"`
+
+	// Indicates that changes to the instance template should not be rolled out
+	// to existing instances. Instances will continue to be created and deleted
+	// as the workload scales.
+	//
+	// Pausing a workload allows multiple changes to be made to the instance
+	// template without triggering a rollout for each change, or an in progress
+	// rollout to be halted.
+	//
+	// +kubebuilder:validation:Optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Indicates that all of the workload's instances should be stopped. Stopped
+	// instances retain their volumes, and are started again when the workload
+	// is no longer suspended.
+	//
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Requests that the workload's instances be restarted or recreated.
+	// Instances created before the request are processed in ordinal order,
+	// following the instance management policy of their placement.
+	//
+	// +kubebuilder:validation:Optional
+	Restart *InstanceRestartRequest `json:"restart,omitempty"`
+
+	// The number of previous instance revisions to retain for each deployment
+	// to allow rollback. Defaults to 10.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=10
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Defines how changes to the instance template are rolled out across the
+	// workload's deployments. Defaults to rolling out changes to all
+	// deployments at once.
+	//
+	// +kubebuilder:validation:Optional
+	RolloutStrategy *WorkloadRolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// When provided, the workload's instance template will be replaced with the
+	// template from the referenced revision. This field is cleared once the
+	// rollback has been processed.
+	//
+	// +kubebuilder:validation:Optional
+	RollbackTo *WorkloadRollbackConfig `json:"rollbackTo,omitempty"`
+}
+
+type InstanceRestartRequest struct {
+	// The time that the restart was requested. Changing this value will
+	// request another restart.
+	//
+	// +kubebuilder:validation:Required
+	RequestedAt metav1.Time `json:"requestedAt"`
+
+	// The type of restart. Can be "Restart" or "Recreate". Defaults to
+	// "Restart".
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Restart;Recreate
+	// +kubebuilder:default=Restart
+	Type InstanceRestartType `json:"type,omitempty"`
+}
+
+type InstanceRestartType string
+
+const (
+	// RestartInstanceRestartType restarts instances in place, retaining their
+	// volumes and network interfaces.
+	RestartInstanceRestartType InstanceRestartType = "Restart"
+
+	// RecreateInstanceRestartType deletes instances and creates them again.
+	RecreateInstanceRestartType InstanceRestartType = "Recreate"
+)
+
+type InstanceRestartStatus struct {
+	// The time of the restart request.
+	RequestedAt metav1.Time `json:"requestedAt"`
+
+	// The time that all instances had been restarted and were ready.
+	//
+	// +kubebuilder:validation:Optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+type WorkloadRollbackConfig struct {
+	// The revision to roll back to. If set to 0, the workload will be rolled
+	// back to the last revision prior to the current instance template.
+	//
+	// Revision numbers are assigned by the workload each time its instance
+	// template changes, and are recorded on the InstanceRevisions of each of
+	// the workload's deployments.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Revision int64 `json:"revision,omitempty"`
 }
 
+type WorkloadRolloutStrategy struct {
+	// The type of rollout strategy. Can be "AllAtOnce" or "Progressive".
+	// Defaults to "AllAtOnce".
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=AllAtOnce;Progressive
+	// +kubebuilder:default=AllAtOnce
+	Type WorkloadRolloutStrategyType `json:"type,omitempty"`
+
+	// Settings for a progressive rollout. Only used when the type is
+	// "Progressive".
+	//
+	// +kubebuilder:validation:Optional
+	Progressive *ProgressiveRolloutStrategy `json:"progressive,omitempty"`
+}
+
+type WorkloadRolloutStrategyType string
+
+const (
+	// AllAtOnceWorkloadRolloutStrategyType will apply changes to the instance
+	// template to all deployments at the same time.
+	AllAtOnceWorkloadRolloutStrategyType WorkloadRolloutStrategyType = "AllAtOnce"
+
+	// ProgressiveWorkloadRolloutStrategyType will apply changes to the instance
+	// template to deployments in waves. A wave is started only after the
+	// deployments in the previous wave have all of their instances updated and
+	// ready for the soak period.
+	ProgressiveWorkloadRolloutStrategyType WorkloadRolloutStrategyType = "Progressive"
+)
+
+type ProgressiveRolloutStrategy struct {
+	// Defines how deployments are grouped into waves. Can be "Placement" or
+	// "City". Defaults to "City".
+	//
+	// Waves are ordered by the order of placements in the workload, and the
+	// order of city codes within each placement.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Placement;City
+	// +kubebuilder:default=City
+	WaveBy RolloutWaveType `json:"waveBy,omitempty"`
+
+	// The number of seconds that all instances in a wave must be updated and
+	// ready before the next wave is started. Defaults to 300.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:default=300
+	SoakSeconds *int32 `json:"soakSeconds,omitempty"`
+
+	// The number of seconds that a wave may take for all of its instances to be
+	// updated and ready. When exceeded, the rollout is halted until the
+	// instance template is changed again. Defaults to 600.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:default=600
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+type RolloutWaveType string
+
+const (
+	// PlacementRolloutWaveType will roll out changes to all deployments in a
+	// placement at once.
+	PlacementRolloutWaveType RolloutWaveType = "Placement"
+
+	// CityRolloutWaveType will roll out changes to all deployments in a city at
+	// once.
+	CityRolloutWaveType RolloutWaveType = "City"
+)
+
 type WorkloadGateway struct {
+	// Defines settings for the gateway created for the workload.
+	//
 	// +kubebuilder:validation:Required
 	Template WorkloadGatewayTemplate `json:"template"`
 
+	// Routes that attach to the workload gateway.
+	//
+	// Parent references will be directed to the gateway created for the
+	// workload, and backend references must refer to a named port defined on
+	// the workload's instance template.
+	//
 	// +kubebuilder:validation:Optional
-	TCPRoutes []gatewayv1alpha2.TCPRouteSpec `json:"tcpRoutes,omitempty"`
+	TCPRoutes []WorkloadGatewayTCPRouteSpec `json:"tcpRoutes,omitempty"`
 }
 
+// WorkloadGatewayTCPRouteSpec mirrors gatewayv1alpha2.TCPRouteSpec.
+//
+// The gateway API v1 types are referenced directly, as the v1alpha2 package
+// defines type aliases carrying validation markers, which controller-gen is
+// unable to process.
+type WorkloadGatewayTCPRouteSpec struct {
+	// The name of the route, which must be unique within the workload gateway.
+	//
+	// The name is used to derive the name of the TCPRoute created for the
+	// workload.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	gatewayv1.CommonRouteSpec `json:",inline"`
+
+	// Rules are a list of TCP matchers and actions.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []WorkloadGatewayTCPRouteRule `json:"rules"`
+}
+
+// WorkloadGatewayTCPRouteRule mirrors gatewayv1alpha2.TCPRouteRule.
+type WorkloadGatewayTCPRouteRule struct {
+	// Name is the name of the route rule. This name MUST be unique within a
+	// Route if it is set.
+	//
+	// +kubebuilder:validation:Optional
+	Name *gatewayv1.SectionName `json:"name,omitempty"`
+
+	// BackendRefs defines the backend(s) where matching requests should be
+	// sent.
+	//
+	// Backends must refer to a named port defined on the workload's instance
+	// template. Each named port is resolved to a Service and EndpointSlice
+	// which contain the addresses of the workload's instances.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	BackendRefs []gatewayv1.BackendRef `json:"backendRefs,omitempty"`
+}
+
+const (
+	// WorkloadGatewayParentRefName is the parent reference name used by routes
+	// in a WorkloadGateway to refer to the gateway created for the workload.
+	WorkloadGatewayParentRefName = "workload-gateway"
+
+	// NamedPortKind is the backend reference kind used by routes in a
+	// WorkloadGateway to refer to a NamedPort on the workload's instances.
+	NamedPortKind = "NamedPort"
+)
+
 type WorkloadGatewayTemplate struct {
 	// Workload specific gateway
 	//
@@ -67,16 +303,76 @@ type WorkloadStatus struct {
 	// The number of instances which are ready.
 	ReadyReplicas int32 `json:"readyReplicas"`
 
+	// The number of instances which have been intentionally stopped.
+	//
+	// +kubebuilder:validation:Optional
+	StoppedReplicas int32 `json:"stoppedReplicas,omitempty"`
+
 	// The current status of placemetns in a workload.
 	Placements []WorkloadPlacementStatus `json:"placements,omitempty"`
 
 	// The status of the workload gateway if configured.
 	Gateway *WorkloadGatewayStatus `json:"gateway,omitempty"`
+
+	// The status of a progressive rollout, if configured.
+	Rollout *WorkloadRolloutStatus `json:"rollout,omitempty"`
+
+	// The revision number of the workload's instance template. The revision
+	// number increases each time the instance template or the placements'
+	// template overrides change.
+	//
+	// +kubebuilder:validation:Optional
+	TemplateRevision int64 `json:"templateRevision,omitempty"`
+
+	// A hash of the instance template and template overrides which the
+	// template revision was assigned to.
+	//
+	// +kubebuilder:validation:Optional
+	TemplateHash string `json:"templateHash,omitempty"`
+}
+
+type WorkloadRolloutStatus struct {
+	// The hash of the instance template being rolled out.
+	TemplateHash string `json:"templateHash"`
+
+	// The index of the wave currently being rolled out.
+	CurrentWave int32 `json:"currentWave"`
+
+	// The total number of waves in the rollout.
+	Waves int32 `json:"waves"`
+
+	// The time at which the current wave was started.
+	//
+	// +kubebuilder:validation:Optional
+	WaveStartTime *metav1.Time `json:"waveStartTime,omitempty"`
+
+	// The time at which all instances in the current wave were observed to be
+	// updated and ready.
+	//
+	// +kubebuilder:validation:Optional
+	WaveReadyTime *metav1.Time `json:"waveReadyTime,omitempty"`
 }
 
 const (
 	// WorkloadAvailable indicates that at least one instance has come online.
 	WorkloadAvailable = "Available"
+
+	// WorkloadProgressing indicates the state of a progressive rollout of the
+	// workload's instance template.
+	WorkloadProgressing = "Progressing"
+
+	// WorkloadPaused indicates whether changes to the instance template are
+	// being withheld from existing instances.
+	WorkloadPaused = "Paused"
+
+	// WorkloadRolledBack indicates the result of the last rollback requested
+	// for the workload.
+	WorkloadRolledBack = "RolledBack"
+
+	// WorkloadPlacementCityCodesResolved indicates whether locations were found
+	// for each of the city codes in a placement. Deployments are not created
+	// for city codes which have no matching locations.
+	WorkloadPlacementCityCodesResolved = "CityCodesResolved"
 )
 
 type WorkloadGatewayStatus struct {
@@ -125,13 +421,130 @@ type WorkloadPlacement struct {
 
 	// A list of city codes that define where the instances should be deployed.
 	//
-	// +kubebuilder:validation:Required
+	// Either cityCodes or locationSelector must be provided.
+	//
+	// +kubebuilder:validation:Optional
 	CityCodes []string `json:"cityCodes,omitempty"`
 
+	// A selector which is matched against the topology of locations, such as
+	// selecting all locations in a region or operated by a provider. A
+	// deployment will be created for each city code found in the topology of
+	// the matching locations.
+	//
+	// When provided along with cityCodes, only locations in the listed cities
+	// will be considered.
+	//
+	// +kubebuilder:validation:Optional
+	LocationSelector *metav1.LabelSelector `json:"locationSelector,omitempty"`
+
 	// Scale settings such as minimum and maximum replica counts.
 	//
 	// +kubebuilder:validation:Required
 	ScaleSettings HorizontalScaleSettings `json:"scaleSettings"`
+
+	// Scale settings for individual cities, replacing the placement's scale
+	// settings for deployments in those cities.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=cityCode
+	CityScaleSettings []CityScaleSettings `json:"cityScaleSettings,omitempty"`
+
+	// Distributes replicas across the cities of the placement. When provided,
+	// the minimum number of replicas in the placement's scale settings is the
+	// total number of replicas for the placement, instead of the number of
+	// replicas for each city.
+	//
+	// Replicas are redistributed as cities are added to or removed from the
+	// placement. Cities which receive no replicas will not have a deployment.
+	//
+	// +kubebuilder:validation:Optional
+	ReplicaDistribution *ReplicaDistribution `json:"replicaDistribution,omitempty"`
+
+	// Indicates that all instances created by the placement should be stopped.
+	//
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Overrides applied to the workload's instance template for deployments
+	// created by the placement, such as using a city-local image registry, or a
+	// different instance type where the default is not offered.
+	//
+	// Overrides are applied in order.
+	//
+	// +kubebuilder:validation:Optional
+	TemplateOverrides []WorkloadTemplateOverride `json:"templateOverrides,omitempty"`
+}
+
+type WorkloadTemplateOverride struct {
+	// Limits the override to deployments in the listed cities. When not
+	// provided, the override applies to all deployments created by the
+	// placement.
+	//
+	// +kubebuilder:validation:Optional
+	CityCodes []string `json:"cityCodes,omitempty"`
+
+	// A strategic merge patch applied to the instance template. Containers,
+	// environment variables, volumes, volume attachments, and ports are merged
+	// by name.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Patch runtime.RawExtension `json:"patch"`
+}
+
+type CityScaleSettings struct {
+	// The city code.
+	//
+	// +kubebuilder:validation:Required
+	CityCode string `json:"cityCode"`
+
+	// Scale settings for deployments in the city.
+	//
+	// +kubebuilder:validation:Required
+	ScaleSettings HorizontalScaleSettings `json:"scaleSettings"`
+}
+
+type ReplicaDistribution struct {
+	// The type of replica distribution.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Even;Weighted
+	// +kubebuilder:default=Even
+	Type ReplicaDistributionType `json:"type,omitempty"`
+
+	// The relative weight of each city when using the Weighted distribution.
+	// Cities which are not listed have a weight of 0.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=cityCode
+	Weights []CityReplicaWeight `json:"weights,omitempty"`
+}
+
+type ReplicaDistributionType string
+
+const (
+	// EvenReplicaDistributionType spreads replicas evenly across the cities of
+	// a placement.
+	EvenReplicaDistributionType ReplicaDistributionType = "Even"
+
+	// WeightedReplicaDistributionType spreads replicas across the cities of a
+	// placement in proportion to their weights.
+	WeightedReplicaDistributionType ReplicaDistributionType = "Weighted"
+)
+
+type CityReplicaWeight struct {
+	// The city code.
+	//
+	// +kubebuilder:validation:Required
+	CityCode string `json:"cityCode"`
+
+	// The relative weight of the city.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Weight int32 `json:"weight"`
 }
 
 type WorkloadPlacementStatus struct {
@@ -139,7 +552,7 @@ type WorkloadPlacementStatus struct {
 	Name string `json:"name"`
 
 	// Represents the observations of a placement's current state.
-	// Known condition types are: "Available", "Progressing"
+	// Known condition types are: "Available", "Progressing", "CityCodesResolved"
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	// The number of instances that currently exist
@@ -153,6 +566,11 @@ type WorkloadPlacementStatus struct {
 
 	// The number of instances which are ready.
 	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// The number of instances which have been intentionally stopped.
+	//
+	// +kubebuilder:validation:Optional
+	StoppedReplicas int32 `json:"stoppedReplicas,omitempty"`
 }
 
 type HorizontalScaleSettings struct {
@@ -171,25 +589,242 @@ type HorizontalScaleSettings struct {
 	// +kubebuilder:validation:Optional
 	Metrics []MetricSpec `json:"metrics,omitempty"`
 
-	// TODO(jreese) wire in behavior
+	// Configures the scaling behavior in both the up and down directions when
+	// the number of instances is driven by metrics.
+	//
 	// See https://github.com/kubernetes/kubernetes/blob/dd87bc064631354885193fc1a97d0e7b603e77b4/staging/src/k8s.io/api/autoscaling/v2/types.go#L84
-	// Defines the policy for managing instances.
-
-	// TODO(jreese) Add instance update policy? RollingUpdate vs OrderedReady
+	//
+	// +kubebuilder:validation:Optional
+	Behavior *HorizontalScalingBehavior `json:"behavior,omitempty"`
 
 	// Controls how instances are managed during scale up and down, as well as
 	// during maintenance events.
 	//
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=OrderedReady;Parallel
 	// +kubebuilder:default=OrderedReady
 	InstanceManagementPolicy InstanceManagementPolicyType `json:"instanceManagementPolicy,omitempty"`
+
+	// Controls how changes to the instance template are rolled out to existing
+	// instances.
+	//
+	// When not provided, instances are updated one at a time in strictly
+	// decreasing order, waiting for all instances to be ready before moving on.
+	//
+	// +kubebuilder:validation:Optional
+	UpdateStrategy *InstanceUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// Configures the automatic repair of instances which remain unhealthy.
+	// Unhealthy instances are deleted and created again, following the
+	// instance management policy.
+	//
+	// +kubebuilder:validation:Optional
+	AutoHealing *AutoHealingPolicy `json:"autoHealing,omitempty"`
+}
+
+type AutoHealingPolicy struct {
+	// The number of seconds that an instance may remain unhealthy before it is
+	// repaired. An instance is unhealthy when it is not ready after having
+	// started running, or when programming the instance has failed. Defaults to
+	// 300.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=300
+	UnhealthyThresholdSeconds *int32 `json:"unhealthyThresholdSeconds,omitempty"`
+
+	// The number of seconds to wait after repairing an instance before it may
+	// be repaired again. The backoff is doubled for each consecutive repair, up
+	// to the maximum backoff. Defaults to 60.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=60
+	InitialBackoffSeconds *int32 `json:"initialBackoffSeconds,omitempty"`
+
+	// The maximum number of seconds to wait between repairs of an instance.
+	// Defaults to 3600.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=3600
+	MaxBackoffSeconds *int32 `json:"maxBackoffSeconds,omitempty"`
+}
+
+type InstanceUpdateStrategy struct {
+	// Type of update strategy.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=RollingUpdate
+	// +kubebuilder:default=RollingUpdate
+	Type InstanceUpdateStrategyType `json:"type,omitempty"`
+
+	// Parameters for the RollingUpdate strategy.
+	//
+	// +kubebuilder:validation:Optional
+	RollingUpdate *RollingUpdateInstanceStrategy `json:"rollingUpdate,omitempty"`
+}
+
+type InstanceUpdateStrategyType string
+
+const (
+	// RollingUpdateInstanceUpdateStrategyType will update instances in strictly
+	// decreasing order, limiting the number of instances which may be
+	// unavailable at once.
+	RollingUpdateInstanceUpdateStrategyType InstanceUpdateStrategyType = "RollingUpdate"
+)
+
+type RollingUpdateInstanceStrategy struct {
+	// The maximum number of instances that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired
+	// instances (ex: 10%). Absolute number is calculated from percentage by
+	// rounding down.
+	//
+	// This can not be 0 if MaxSurge is 0. Defaults to 1.
+	//
+	// +kubebuilder:validation:Optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of instances that can be created above the desired
+	// number of instances during the update. Value can be an absolute number
+	// (ex: 5) or a percentage of desired instances (ex: 10%). Absolute number is
+	// calculated from percentage by rounding up.
+	//
+	// Surge instances are created with the latest instance template, and are
+	// removed once all other instances have been updated. Defaults to 0.
+	//
+	// +kubebuilder:validation:Optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// Instances with an ordinal greater than or equal to the partition will be
+	// updated when the instance template changes. Instances with an ordinal
+	// less than the partition will not be updated, allowing a change to be
+	// canaried on the highest ordinals. Defaults to 0.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Partition *int32 `json:"partition,omitempty"`
+}
+
+// HorizontalScalingBehavior configures the scaling behavior in both the up and
+// down directions.
+type HorizontalScalingBehavior struct {
+	// Scaling policy for scaling up.
+	//
+	// If not set, the default value is the higher of:
+	//   * increase no more than 4 instances per 15 seconds
+	//   * double the number of instances per 15 seconds
+	// No stabilization is used.
+	//
+	// +kubebuilder:validation:Optional
+	ScaleUp *HorizontalScalingRules `json:"scaleUp,omitempty"`
+
+	// Scaling policy for scaling down.
+	//
+	// If not set, the default value is to allow scaling down to the minimum
+	// number of instances, with a 300 second stabilization window.
+	//
+	// +kubebuilder:validation:Optional
+	ScaleDown *HorizontalScalingRules `json:"scaleDown,omitempty"`
+}
+
+// HorizontalScalingRules configures the scaling behavior for one direction.
+// These rules are applied after calculating the desired number of instances
+// from metrics.
+type HorizontalScalingRules struct {
+	// The number of seconds for which past recommendations should be considered
+	// while scaling up or scaling down. For scale up, the lowest recommendation
+	// within the window is used, and for scale down, the highest
+	// recommendation is used.
+	//
+	// If not set, use the default values:
+	//   * For scale up: 0 (i.e. no stabilization is done).
+	//   * For scale down: 300 (i.e. the stabilization window is 300 seconds long).
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+
+	// Specifies which policy should be used. If not set, the default value Max
+	// is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Max;Min;Disabled
+	SelectPolicy *ScalingPolicySelect `json:"selectPolicy,omitempty"`
+
+	// A list of potential scaling polices which can be used during scaling. If
+	// not set, the default policies for the direction are used.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Policies []HorizontalScalingPolicy `json:"policies,omitempty"`
+}
+
+// ScalingPolicySelect is used to specify which policy should be used while
+// scaling in a certain direction.
+type ScalingPolicySelect string
+
+const (
+	// MaxChangePolicySelect selects the policy with the highest possible change.
+	MaxChangePolicySelect ScalingPolicySelect = "Max"
+
+	// MinChangePolicySelect selects the policy with the lowest possible change.
+	MinChangePolicySelect ScalingPolicySelect = "Min"
+
+	// DisabledPolicySelect disables scaling in this direction.
+	DisabledPolicySelect ScalingPolicySelect = "Disabled"
+)
+
+// HorizontalScalingPolicyType is the type of the policy which could be used
+// while making scaling decisions.
+type HorizontalScalingPolicyType string
+
+const (
+	// InstancesScalingPolicy is a policy used to specify a change in absolute
+	// number of instances.
+	InstancesScalingPolicy HorizontalScalingPolicyType = "Instances"
+
+	// PercentScalingPolicy is a policy used to specify a relative amount of
+	// change with respect to the current number of instances.
+	PercentScalingPolicy HorizontalScalingPolicyType = "Percent"
+)
+
+// HorizontalScalingPolicy is a single policy which must hold true for a
+// specified past interval.
+type HorizontalScalingPolicy struct {
+	// Type is used to specify the scaling policy.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Instances;Percent
+	Type HorizontalScalingPolicyType `json:"type"`
+
+	// Value contains the amount of change which is permitted by the policy.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	Value int32 `json:"value"`
+
+	// The window of time for which the policy should hold true.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1800
+	PeriodSeconds int32 `json:"periodSeconds"`
 }
 
 type InstanceManagementPolicyType string
 
 const (
+	// OrderedReadyInstanceManagementPolicyType will create instances in strictly
+	// increasing order, waiting for each instance to be ready before continuing.
+	// Updates and deletions are processed one at a time in strictly decreasing
+	// order.
 	OrderedReadyInstanceManagementPolicyType InstanceManagementPolicyType = "OrderedReady"
-	// ParallelInstanceManagementPolicyType     InstanceManagementPolicyType = "Parallel"
+
+	// ParallelInstanceManagementPolicyType will create, update, and delete
+	// instances all at once, without waiting for instances to become ready.
+	ParallelInstanceManagementPolicyType InstanceManagementPolicyType = "Parallel"
 )
 
 type MetricSpec struct {
@@ -1,6 +1,8 @@
 package v1alpha
 
 import (
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
@@ -18,12 +20,17 @@ type WorkloadDeploymentSpec struct {
 	// +kubebuilder:validation:Required
 	PlacementName string `json:"placementName"`
 
-	// TODO(jreese) think through how to structure this a bit better for when
-	// deployments can be scheduled in ways other than just a city code.
+	// The city code of the locations which the deployment may be scheduled to.
 	//
 	// +kubebuilder:validation:Required
 	CityCode string `json:"cityCode"`
 
+	// Further restricts the locations which the deployment may be scheduled to,
+	// matched against the topology of locations.
+	//
+	// +kubebuilder:validation:Optional
+	LocationSelector *metav1.LabelSelector `json:"locationSelector,omitempty"`
+
 	// Defines settings for each instance.
 	//
 	// +kubebuilder:validation:Required
@@ -33,6 +40,31 @@ type WorkloadDeploymentSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	ScaleSettings HorizontalScaleSettings `json:"scaleSettings"`
+
+	// Indicates that changes to the instance template should not be rolled out
+	// to existing instances. Instances will continue to be created and deleted
+	// as the deployment scales.
+	//
+	// +kubebuilder:validation:Optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Indicates that all of the deployment's instances should be stopped.
+	//
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Requests that the deployment's instances be restarted or recreated.
+	//
+	// +kubebuilder:validation:Optional
+	Restart *InstanceRestartRequest `json:"restart,omitempty"`
+
+	// The number of previous instance revisions to retain to allow rollback.
+	// Defaults to 10.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=10
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 }
 
 // WorkloadDeploymentStatus defines the observed state of WorkloadDeployment
@@ -57,12 +89,137 @@ type WorkloadDeploymentStatus struct {
 
 	// The number of instances which are ready.
 	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// The number of instances which have been intentionally stopped.
+	//
+	// +kubebuilder:validation:Optional
+	StoppedReplicas int32 `json:"stoppedReplicas,omitempty"`
+
+	// The generation of the deployment observed by the controller when the
+	// replica counts were last updated.
+	//
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The name of the InstanceRevision which captures the deployment's current
+	// instance template. While the deployment is paused, the current revision
+	// is not advanced when the instance template changes, and new instances are
+	// created from the template of the current revision.
+	//
+	// +kubebuilder:validation:Optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+
+	// The state of the autoscaler for the deployment. Only present when the
+	// deployment's scale settings define a maximum number of replicas.
+	//
+	// +kubebuilder:validation:Optional
+	Autoscaling *WorkloadDeploymentAutoscalingStatus `json:"autoscaling,omitempty"`
+
+	// The progress of the most recent restart request.
+	//
+	// +kubebuilder:validation:Optional
+	LastRestart *InstanceRestartStatus `json:"lastRestart,omitempty"`
+
+	// Instances which have been repaired by the deployment's auto healing
+	// policy, and have not yet remained ready for the policy's unhealthy
+	// threshold.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Healing []InstanceHealingStatus `json:"healing,omitempty"`
+}
+
+type InstanceHealingStatus struct {
+	// The name of the instance.
+	Name string `json:"name"`
+
+	// The number of times the instance has been repaired.
+	Attempts int32 `json:"attempts"`
+
+	// The last time the instance was repaired.
+	LastAttemptTime metav1.Time `json:"lastAttemptTime"`
+
+	// The reason the instance was last repaired.
+	Reason string `json:"reason"`
+
+	// A human readable message describing why the instance was last repaired.
+	//
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+type WorkloadDeploymentAutoscalingStatus struct {
+	// The desired number of instances as last calculated by the autoscaler.
+	// This value will always be within the minimum and maximum replicas defined
+	// in the deployment's scale settings.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// The last time the autoscaler changed the desired number of instances.
+	//
+	// +kubebuilder:validation:Optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// The last observed values of the metrics used by the autoscaler.
+	//
+	// +kubebuilder:validation:Optional
+	CurrentMetrics []MetricStatus `json:"currentMetrics,omitempty"`
+}
+
+type MetricStatus struct {
+	// Resource metrics known to Datum.
+	//
+	// +kubebuilder:validation:Optional
+	Resource *ResourceMetricStatus `json:"resource,omitempty"`
+}
+
+type ResourceMetricStatus struct {
+	// The name of the resource in question.
+	//
+	// +kubebuilder:validation:Required
+	Name k8scorev1.ResourceName `json:"name"`
+
+	// The current value for the given metric
+	//
+	// +kubebuilder:validation:Required
+	Current MetricValueStatus `json:"current"`
+}
+
+type MetricValueStatus struct {
+	// The current value of the metric (as a quantity).
+	//
+	// +kubebuilder:validation:Optional
+	Value *resource.Quantity `json:"value,omitempty"`
+
+	// The current value of the average of the metric across all relevant
+	// instances (as a quantity)
+	//
+	// +kubebuilder:validation:Optional
+	AverageValue *resource.Quantity `json:"averageValue,omitempty"`
+
+	// The current value of the average of the resource metric across all
+	// relevant instances, represented as a percentage of the capacity of the
+	// resource for the instances.
+	//
+	// +kubebuilder:validation:Optional
+	AverageUtilization *int32 `json:"averageUtilization,omitempty"`
 }
 
 const (
 	// WorkloadDeploymentAvailable indicates that at least one instance has come
 	// online.
 	WorkloadDeploymentAvailable = "Available"
+
+	// WorkloadDeploymentScalingActive indicates that the autoscaler is able to
+	// fetch metrics and calculate the desired number of instances.
+	WorkloadDeploymentScalingActive = "ScalingActive"
+
+	// WorkloadDeploymentRescheduling indicates that the deployment's location is
+	// no longer viable. While true, instances are drained from the location,
+	// after which the deployment is scheduled to a new location. The condition
+	// is set to false once the deployment has been removed from the location,
+	// retaining the reason it was moved.
+	WorkloadDeploymentRescheduling = "Rescheduling"
 )
 
 // +kubebuilder:object:root=true
@@ -76,6 +233,7 @@ const (
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.readyReplicas`
 // +kubebuilder:printcolumn:name="Desired",type=string,JSONPath=`.status.desiredReplicas`
 // +kubebuilder:printcolumn:name="Up-to-date",type=string,JSONPath=`.status.currentReplicas`
+// +kubebuilder:printcolumn:name="Revision",type=string,JSONPath=`.status.currentRevision`,priority=1
 // +kubebuilder:printcolumn:name="Location Namespace",type=string,JSONPath=`.status.location.namespace`,priority=1
 // +kubebuilder:printcolumn:name="Location Name",type=string,JSONPath=`.status.location.name`,priority=1
 type WorkloadDeployment struct {
@@ -25,6 +25,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
 	"sigs.k8s.io/multicluster-runtime/pkg/multicluster"
 	mcsingle "sigs.k8s.io/multicluster-runtime/providers/single"
@@ -33,6 +35,7 @@ import (
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
 	"go.datum.net/workload-operator/internal/config"
 	"go.datum.net/workload-operator/internal/controller"
+	"go.datum.net/workload-operator/internal/controller/autoscaling"
 	computewebhook "go.datum.net/workload-operator/internal/webhook"
 	computev1alphawebhooks "go.datum.net/workload-operator/internal/webhook/v1alpha"
 	multiclusterproviders "go.miloapis.com/milo/pkg/multicluster-runtime"
@@ -53,6 +56,8 @@ func init() {
 	utilruntime.Must(config.RegisterDefaults(scheme))
 	utilruntime.Must(computev1alpha.AddToScheme(scheme))
 	utilruntime.Must(networkingv1alpha.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(gatewayv1alpha2.Install(scheme))
 
 	// +kubebuilder:scaffold:scheme
 }
@@ -164,10 +169,29 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "WorkloadDeployment")
 		os.Exit(1)
 	}
-	if err = (&controller.WorkloadDeploymentScheduler{}).SetupWithManager(mgr); err != nil {
+	if err = (&controller.WorkloadDeploymentScheduler{
+		Config: serverConfig.Scheduler,
+	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "WorkloadDeploymentScheduler")
 		os.Exit(1)
 	}
+	if promConfig := serverConfig.Autoscaling.Prometheus; promConfig != nil {
+		metricsSource, err := autoscaling.NewPrometheusMetricsSource(*promConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to create metrics source")
+			os.Exit(1)
+		}
+
+		if err = (&controller.WorkloadDeploymentAutoscaler{
+			MetricsSource: metricsSource,
+			SyncPeriod:    serverConfig.Autoscaling.SyncPeriod.Duration,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "WorkloadDeploymentAutoscaler")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("no metrics source configured, autoscaling is disabled")
+	}
 	if err = (&controller.InstanceReconciler{}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Instance")
 		os.Exit(1)
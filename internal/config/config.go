@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +34,10 @@ type WorkloadOperator struct {
 	WebhookServer WebhookServerConfig `json:"webhookServer"`
 
 	Discovery DiscoveryConfig `json:"discovery"`
+
+	Autoscaling AutoscalingConfig `json:"autoscaling"`
+
+	Scheduler SchedulerConfig `json:"scheduler"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -250,6 +255,102 @@ func (c *DiscoveryConfig) ProjectRestConfig() (*rest.Config, error) {
 	return clientcmd.BuildConfigFromFlags("", c.ProjectKubeconfigPath)
 }
 
+// +k8s:deepcopy-gen=true
+
+type AutoscalingConfig struct {
+	// SyncPeriod is the interval at which the desired number of instances for
+	// autoscaled deployments will be recalculated.
+	//
+	// Defaults to 30 seconds.
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+
+	// Prometheus configures a Prometheus compatible API to be used as the source
+	// of instance metrics. When not provided, deployments will not be
+	// autoscaled, and will be sized to their minimum number of replicas.
+	Prometheus *PrometheusMetricsSourceConfig `json:"prometheus,omitempty"`
+}
+
+func SetDefaults_AutoscalingConfig(obj *AutoscalingConfig) {
+	if obj.SyncPeriod.Duration == 0 {
+		obj.SyncPeriod = metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// +k8s:deepcopy-gen=true
+
+type PrometheusMetricsSourceConfig struct {
+	// Address is the URL of the Prometheus compatible API.
+	Address string `json:"address"`
+
+	// CPUUsageQuery is a template for a PromQL query that returns the CPU usage
+	// of each instance in a deployment, in cores. The template is provided the
+	// ClusterName, Namespace, and Deployment of the deployment being scaled.
+	CPUUsageQuery string `json:"cpuUsageQuery"`
+
+	// CPUCapacityQuery is a template for a PromQL query that returns the number
+	// of cores available to each instance in a deployment. The query is required
+	// when a deployment targets an average CPU utilization.
+	CPUCapacityQuery string `json:"cpuCapacityQuery"`
+
+	// InstanceLabel is the label on query results which contains the name of the
+	// instance.
+	//
+	// Defaults to "instance_name".
+	InstanceLabel string `json:"instanceLabel"`
+}
+
+func SetDefaults_PrometheusMetricsSourceConfig(obj *PrometheusMetricsSourceConfig) {
+	if len(obj.InstanceLabel) == 0 {
+		obj.InstanceLabel = "instance_name"
+	}
+}
+
+// +k8s:deepcopy-gen=true
+
+type SchedulerConfig struct {
+	// Plugins are used to select a location for each deployment. Filter plugins
+	// are run in the order provided, removing locations which a deployment may
+	// not be scheduled to. Remaining locations are ranked by the weighted sum of
+	// the scores from each score plugin.
+	//
+	// Supported plugins are LocationHealth, Topology, InstanceType, Capacity, and
+	// LeastLoaded. Defaults to all supported plugins. The LocationHealth and
+	// Topology plugins are always run first when they are not provided, as
+	// deployments are moved out of locations which they reject.
+	Plugins []SchedulerPluginConfig `json:"plugins"`
+}
+
+func SetDefaults_SchedulerConfig(obj *SchedulerConfig) {
+	if len(obj.Plugins) == 0 {
+		obj.Plugins = []SchedulerPluginConfig{
+			{Name: "LocationHealth"},
+			{Name: "Topology"},
+			{Name: "InstanceType"},
+			{Name: "Capacity"},
+			{Name: "LeastLoaded"},
+		}
+	}
+}
+
+// +k8s:deepcopy-gen=true
+
+type SchedulerPluginConfig struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+
+	// Weight is the multiplier applied to scores from the plugin. Ignored for
+	// plugins which do not score locations.
+	//
+	// Defaults to 1.
+	Weight int64 `json:"weight"`
+}
+
+func SetDefaults_SchedulerPluginConfig(obj *SchedulerPluginConfig) {
+	if obj.Weight == 0 {
+		obj.Weight = 1
+	}
+}
+
 func init() {
 	SchemeBuilder.Register(&WorkloadOperator{})
 }
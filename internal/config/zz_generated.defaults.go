@@ -23,4 +23,13 @@ func SetObjectDefaults_WorkloadOperator(in *WorkloadOperator) {
 	SetDefaults_WebhookServerConfig(&in.WebhookServer)
 	SetDefaults_TLSConfig(&in.WebhookServer.TLS)
 	SetDefaults_DiscoveryConfig(&in.Discovery)
+	SetDefaults_AutoscalingConfig(&in.Autoscaling)
+	if in.Autoscaling.Prometheus != nil {
+		SetDefaults_PrometheusMetricsSourceConfig(in.Autoscaling.Prometheus)
+	}
+	SetDefaults_SchedulerConfig(&in.Scheduler)
+	for i := range in.Scheduler.Plugins {
+		a := &in.Scheduler.Plugins[i]
+		SetDefaults_SchedulerPluginConfig(a)
+	}
 }
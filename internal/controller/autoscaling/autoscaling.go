@@ -0,0 +1,169 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// Behavior inspired by https://github.com/kubernetes/kubernetes/tree/master/pkg/controller/podautoscaler
+
+// MetricsSource provides metrics for the instances of a deployment.
+type MetricsSource interface {
+	// GetResourceMetric returns the current usage of a resource for each
+	// instance in the deployment, keyed by instance name.
+	GetResourceMetric(
+		ctx context.Context,
+		deployment *v1alpha.WorkloadDeployment,
+		resourceName k8scorev1.ResourceName,
+	) (InstanceMetrics, error)
+}
+
+// InstanceMetric is the observed usage of a resource by an instance.
+type InstanceMetric struct {
+	// Usage is the amount of the resource being used by the instance.
+	Usage resource.Quantity
+
+	// Capacity is the amount of the resource available to the instance. This
+	// is required in order to calculate utilization.
+	Capacity *resource.Quantity
+}
+
+// InstanceMetrics is a set of instance metrics keyed by instance name.
+type InstanceMetrics map[string]InstanceMetric
+
+// DefaultTolerance is the ratio that the current metric value may deviate from
+// the target before the number of instances will be changed.
+const DefaultTolerance = 0.1
+
+// ReplicaCalculator calculates the number of instances desired for a
+// deployment based on the metrics defined in its scale settings.
+type ReplicaCalculator struct {
+	source    MetricsSource
+	tolerance float64
+}
+
+func NewReplicaCalculator(source MetricsSource, tolerance float64) *ReplicaCalculator {
+	return &ReplicaCalculator{
+		source:    source,
+		tolerance: tolerance,
+	}
+}
+
+// GetDesiredReplicas returns the number of instances that should exist for the
+// deployment, bounded by the minimum and maximum replicas in the deployment's
+// scale settings. When multiple metrics are defined, the largest number of
+// instances proposed by any metric is used.
+//
+// The current values of each metric are also returned.
+func (c *ReplicaCalculator) GetDesiredReplicas(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	currentReplicas int32,
+) (int32, []v1alpha.MetricStatus, error) {
+	scaleSettings := deployment.Spec.ScaleSettings
+	if scaleSettings.MaxReplicas == nil {
+		return 0, nil, fmt.Errorf("deployment does not define a maximum number of replicas")
+	}
+
+	var desiredReplicas int32
+	var metricStatuses []v1alpha.MetricStatus
+	for i, metric := range scaleSettings.Metrics {
+		if metric.Resource == nil {
+			return 0, nil, fmt.Errorf("metric %d does not define a supported source", i)
+		}
+
+		proposedReplicas, metricStatus, err := c.getResourceReplicas(ctx, deployment, currentReplicas, *metric.Resource)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed calculating replicas for resource %q: %w", metric.Resource.Name, err)
+		}
+
+		desiredReplicas = max(desiredReplicas, proposedReplicas)
+		metricStatuses = append(metricStatuses, metricStatus)
+	}
+
+	desiredReplicas = max(scaleSettings.MinReplicas, min(desiredReplicas, *scaleSettings.MaxReplicas))
+
+	return desiredReplicas, metricStatuses, nil
+}
+
+func (c *ReplicaCalculator) getResourceReplicas(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	currentReplicas int32,
+	source v1alpha.ResourceMetricSource,
+) (int32, v1alpha.MetricStatus, error) {
+	metricStatus := v1alpha.MetricStatus{
+		Resource: &v1alpha.ResourceMetricStatus{
+			Name: source.Name,
+		},
+	}
+
+	metrics, err := c.source.GetResourceMetric(ctx, deployment, source.Name)
+	if err != nil {
+		return 0, metricStatus, fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	if len(metrics) == 0 {
+		return 0, metricStatus, fmt.Errorf("no metrics returned from metrics source")
+	}
+
+	var totalUsage int64
+	var totalCapacity int64
+	capacityKnown := true
+	for _, m := range metrics {
+		totalUsage += m.Usage.MilliValue()
+		if m.Capacity == nil {
+			capacityKnown = false
+		} else {
+			totalCapacity += m.Capacity.MilliValue()
+		}
+	}
+
+	instanceCount := int64(len(metrics))
+	target := source.Target
+	current := &metricStatus.Resource.Current
+
+	var usageRatio float64
+	var proposedReplicas int32
+	switch {
+	case target.AverageUtilization != nil:
+		if !capacityKnown || totalCapacity == 0 {
+			return 0, metricStatus, fmt.Errorf("unable to determine capacity for all instances")
+		}
+
+		utilization := int32((totalUsage * 100) / totalCapacity)
+		current.AverageUtilization = ptr.To(utilization)
+
+		usageRatio = float64(utilization) / float64(*target.AverageUtilization)
+		proposedReplicas = int32(math.Ceil(usageRatio * float64(instanceCount)))
+
+	case target.AverageValue != nil:
+		current.AverageValue = resource.NewMilliQuantity(totalUsage/instanceCount, resource.DecimalSI)
+
+		usageRatio = float64(totalUsage) / float64(target.AverageValue.MilliValue()*instanceCount)
+		proposedReplicas = int32(math.Ceil(float64(totalUsage) / float64(target.AverageValue.MilliValue())))
+
+	case target.Value != nil:
+		current.Value = resource.NewMilliQuantity(totalUsage, resource.DecimalSI)
+
+		usageRatio = float64(totalUsage) / float64(target.Value.MilliValue())
+		proposedReplicas = int32(math.Ceil(usageRatio * float64(currentReplicas)))
+
+	default:
+		return 0, metricStatus, fmt.Errorf("metric does not define a target")
+	}
+
+	// Avoid thrashing when usage is close to the target.
+	if math.Abs(1.0-usageRatio) <= c.tolerance {
+		return currentReplicas, metricStatus, nil
+	}
+
+	return proposedReplicas, metricStatus, nil
+}
@@ -0,0 +1,180 @@
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// memoryMetricsSource is an in-memory MetricsSource used for testing.
+type memoryMetricsSource struct {
+	metrics map[k8scorev1.ResourceName]InstanceMetrics
+	err     error
+}
+
+func (s *memoryMetricsSource) GetResourceMetric(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	resourceName k8scorev1.ResourceName,
+) (InstanceMetrics, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.metrics[resourceName], nil
+}
+
+func TestAverageUtilizationScaleUp(t *testing.T) {
+	source := &memoryMetricsSource{
+		metrics: map[k8scorev1.ResourceName]InstanceMetrics{
+			k8scorev1.ResourceCPU: getCPUMetrics("900m", "1", 2),
+		},
+	}
+	calculator := NewReplicaCalculator(source, DefaultTolerance)
+
+	deployment := getWorkloadDeployment(1, 10, v1alpha.MetricTarget{
+		AverageUtilization: ptr.To(int32(50)),
+	})
+
+	desiredReplicas, metricStatuses, err := calculator.GetDesiredReplicas(context.Background(), deployment, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), desiredReplicas)
+	assert.Len(t, metricStatuses, 1)
+	assert.Equal(t, int32(90), *metricStatuses[0].Resource.Current.AverageUtilization)
+}
+
+func TestAverageUtilizationScaleDownBoundedByMinReplicas(t *testing.T) {
+	source := &memoryMetricsSource{
+		metrics: map[k8scorev1.ResourceName]InstanceMetrics{
+			k8scorev1.ResourceCPU: getCPUMetrics("10m", "1", 4),
+		},
+	}
+	calculator := NewReplicaCalculator(source, DefaultTolerance)
+
+	deployment := getWorkloadDeployment(2, 10, v1alpha.MetricTarget{
+		AverageUtilization: ptr.To(int32(50)),
+	})
+
+	desiredReplicas, _, err := calculator.GetDesiredReplicas(context.Background(), deployment, 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), desiredReplicas)
+}
+
+func TestAverageValueBoundedByMaxReplicas(t *testing.T) {
+	source := &memoryMetricsSource{
+		metrics: map[k8scorev1.ResourceName]InstanceMetrics{
+			k8scorev1.ResourceCPU: getCPUMetrics("2", "", 3),
+		},
+	}
+	calculator := NewReplicaCalculator(source, DefaultTolerance)
+
+	deployment := getWorkloadDeployment(1, 5, v1alpha.MetricTarget{
+		AverageValue: ptr.To(resource.MustParse("500m")),
+	})
+
+	desiredReplicas, metricStatuses, err := calculator.GetDesiredReplicas(context.Background(), deployment, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), desiredReplicas)
+	assert.True(t, metricStatuses[0].Resource.Current.AverageValue.Equal(resource.MustParse("2")))
+}
+
+func TestWithinTolerance(t *testing.T) {
+	source := &memoryMetricsSource{
+		metrics: map[k8scorev1.ResourceName]InstanceMetrics{
+			k8scorev1.ResourceCPU: getCPUMetrics("520m", "1", 3),
+		},
+	}
+	calculator := NewReplicaCalculator(source, DefaultTolerance)
+
+	deployment := getWorkloadDeployment(1, 10, v1alpha.MetricTarget{
+		AverageUtilization: ptr.To(int32(50)),
+	})
+
+	desiredReplicas, _, err := calculator.GetDesiredReplicas(context.Background(), deployment, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), desiredReplicas)
+}
+
+func TestUtilizationWithoutCapacity(t *testing.T) {
+	source := &memoryMetricsSource{
+		metrics: map[k8scorev1.ResourceName]InstanceMetrics{
+			k8scorev1.ResourceCPU: getCPUMetrics("500m", "", 2),
+		},
+	}
+	calculator := NewReplicaCalculator(source, DefaultTolerance)
+
+	deployment := getWorkloadDeployment(1, 10, v1alpha.MetricTarget{
+		AverageUtilization: ptr.To(int32(50)),
+	})
+
+	_, _, err := calculator.GetDesiredReplicas(context.Background(), deployment, 2)
+
+	assert.Error(t, err)
+}
+
+func TestMetricsSourceError(t *testing.T) {
+	source := &memoryMetricsSource{
+		err: errors.New("metrics unavailable"),
+	}
+	calculator := NewReplicaCalculator(source, DefaultTolerance)
+
+	deployment := getWorkloadDeployment(1, 10, v1alpha.MetricTarget{
+		AverageUtilization: ptr.To(int32(50)),
+	})
+
+	_, _, err := calculator.GetDesiredReplicas(context.Background(), deployment, 2)
+
+	assert.ErrorContains(t, err, "metrics unavailable")
+}
+
+func getWorkloadDeployment(minReplicas, maxReplicas int32, target v1alpha.MetricTarget) *v1alpha.WorkloadDeployment {
+	return &v1alpha.WorkloadDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-deploy",
+			Namespace: "default",
+		},
+		Spec: v1alpha.WorkloadDeploymentSpec{
+			ScaleSettings: v1alpha.HorizontalScaleSettings{
+				MinReplicas: minReplicas,
+				MaxReplicas: ptr.To(maxReplicas),
+				Metrics: []v1alpha.MetricSpec{
+					{
+						Resource: &v1alpha.ResourceMetricSource{
+							Name:   k8scorev1.ResourceCPU,
+							Target: target,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getCPUMetrics(usage, capacity string, instances int) InstanceMetrics {
+	metrics := InstanceMetrics{}
+	for i := range instances {
+		metric := InstanceMetric{
+			Usage: resource.MustParse(usage),
+		}
+
+		if capacity != "" {
+			metric.Capacity = ptr.To(resource.MustParse(capacity))
+		}
+
+		metrics[fmt.Sprintf("test-deploy-%d", i)] = metric
+	}
+
+	return metrics
+}
@@ -0,0 +1,384 @@
+package autoscaling
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+var (
+	defaultScaleUpRules = v1alpha.HorizontalScalingRules{
+		StabilizationWindowSeconds: ptr.To(int32(0)),
+		SelectPolicy:               ptr.To(v1alpha.MaxChangePolicySelect),
+		Policies: []v1alpha.HorizontalScalingPolicy{
+			{
+				Type:          v1alpha.InstancesScalingPolicy,
+				Value:         4,
+				PeriodSeconds: 15,
+			},
+			{
+				Type:          v1alpha.PercentScalingPolicy,
+				Value:         100,
+				PeriodSeconds: 15,
+			},
+		},
+	}
+
+	defaultScaleDownRules = v1alpha.HorizontalScalingRules{
+		StabilizationWindowSeconds: ptr.To(int32(300)),
+		SelectPolicy:               ptr.To(v1alpha.MaxChangePolicySelect),
+		Policies: []v1alpha.HorizontalScalingPolicy{
+			{
+				Type:          v1alpha.PercentScalingPolicy,
+				Value:         100,
+				PeriodSeconds: 15,
+			},
+		},
+	}
+)
+
+type timestampedRecommendation struct {
+	recommendation int32
+	timestamp      time.Time
+}
+
+type timestampedScaleEvent struct {
+	replicaChange int32
+	timestamp     time.Time
+}
+
+// BehaviorNormalizer applies the scaling behavior of a deployment to the
+// desired number of instances calculated from metrics. Past recommendations and
+// scale events are retained in memory, keyed by deployment.
+//
+// Behavior inspired by https://github.com/kubernetes/kubernetes/blob/master/pkg/controller/podautoscaler/horizontal.go
+type BehaviorNormalizer struct {
+	mu              sync.Mutex
+	recommendations map[string][]timestampedRecommendation
+	scaleUpEvents   map[string][]timestampedScaleEvent
+	scaleDownEvents map[string][]timestampedScaleEvent
+
+	now func() time.Time
+}
+
+func NewBehaviorNormalizer() *BehaviorNormalizer {
+	return &BehaviorNormalizer{
+		recommendations: map[string][]timestampedRecommendation{},
+		scaleUpEvents:   map[string][]timestampedScaleEvent{},
+		scaleDownEvents: map[string][]timestampedScaleEvent{},
+		now:             time.Now,
+	}
+}
+
+// Normalize returns the number of instances that the deployment identified by
+// key should be scaled to, taking into account stabilization windows and scale
+// rate limits. The result is recorded as a scale event if it differs from the
+// current number of instances.
+func (n *BehaviorNormalizer) Normalize(
+	key string,
+	scaleSettings v1alpha.HorizontalScaleSettings,
+	currentReplicas int32,
+	desiredReplicas int32,
+) int32 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	scaleUpRules, scaleDownRules := getScalingRules(scaleSettings.Behavior)
+	now := n.now()
+
+	stabilizedReplicas := n.stabilizeRecommendation(key, now, scaleUpRules, scaleDownRules, currentReplicas, desiredReplicas)
+
+	normalizedReplicas := stabilizedReplicas
+	if stabilizedReplicas > currentReplicas {
+		scaleUpLimit := calculateScaleUpLimit(
+			currentReplicas,
+			n.scaleUpEvents[key],
+			n.scaleDownEvents[key],
+			now,
+			scaleUpRules,
+		)
+		// Scaling up may be disabled, in which case the limit will be the current
+		// number of instances.
+		scaleUpLimit = max(scaleUpLimit, currentReplicas)
+		if scaleSettings.MaxReplicas != nil {
+			scaleUpLimit = min(scaleUpLimit, *scaleSettings.MaxReplicas)
+		}
+		normalizedReplicas = min(stabilizedReplicas, scaleUpLimit)
+	} else if stabilizedReplicas < currentReplicas {
+		scaleDownLimit := calculateScaleDownLimit(
+			currentReplicas,
+			n.scaleUpEvents[key],
+			n.scaleDownEvents[key],
+			now,
+			scaleDownRules,
+		)
+		scaleDownLimit = min(scaleDownLimit, currentReplicas)
+		scaleDownLimit = max(scaleDownLimit, scaleSettings.MinReplicas)
+		normalizedReplicas = max(stabilizedReplicas, scaleDownLimit)
+	}
+
+	if normalizedReplicas != currentReplicas {
+		n.storeScaleEvent(key, now, scaleUpRules, scaleDownRules, currentReplicas, normalizedReplicas)
+	}
+
+	return normalizedReplicas
+}
+
+// Forget removes all recommendations and scale events for the deployment
+// identified by key.
+func (n *BehaviorNormalizer) Forget(key string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.recommendations, key)
+	delete(n.scaleUpEvents, key)
+	delete(n.scaleDownEvents, key)
+}
+
+func getScalingRules(behavior *v1alpha.HorizontalScalingBehavior) (v1alpha.HorizontalScalingRules, v1alpha.HorizontalScalingRules) {
+	scaleUpRules := defaultScaleUpRules
+	scaleDownRules := defaultScaleDownRules
+
+	if behavior != nil {
+		scaleUpRules = mergeScalingRules(scaleUpRules, behavior.ScaleUp)
+		scaleDownRules = mergeScalingRules(scaleDownRules, behavior.ScaleDown)
+	}
+
+	return scaleUpRules, scaleDownRules
+}
+
+// mergeScalingRules returns the provided rules, with any unset fields populated
+// from the defaults.
+func mergeScalingRules(defaults v1alpha.HorizontalScalingRules, rules *v1alpha.HorizontalScalingRules) v1alpha.HorizontalScalingRules {
+	if rules == nil {
+		return defaults
+	}
+
+	merged := *rules.DeepCopy()
+	if merged.StabilizationWindowSeconds == nil {
+		merged.StabilizationWindowSeconds = defaults.StabilizationWindowSeconds
+	}
+
+	if merged.SelectPolicy == nil {
+		merged.SelectPolicy = defaults.SelectPolicy
+	}
+
+	if len(merged.Policies) == 0 {
+		merged.Policies = defaults.Policies
+	}
+
+	return merged
+}
+
+// stabilizeRecommendation records the desired number of instances, and returns
+// the number of instances to scale to after considering past recommendations
+// within the stabilization windows.
+func (n *BehaviorNormalizer) stabilizeRecommendation(
+	key string,
+	now time.Time,
+	scaleUpRules v1alpha.HorizontalScalingRules,
+	scaleDownRules v1alpha.HorizontalScalingRules,
+	currentReplicas int32,
+	desiredReplicas int32,
+) int32 {
+	upRecommendation := desiredReplicas
+	upCutoff := now.Add(-time.Duration(*scaleUpRules.StabilizationWindowSeconds) * time.Second)
+
+	downRecommendation := desiredReplicas
+	downCutoff := now.Add(-time.Duration(*scaleDownRules.StabilizationWindowSeconds) * time.Second)
+
+	longestCutoff := upCutoff
+	if downCutoff.Before(longestCutoff) {
+		longestCutoff = downCutoff
+	}
+
+	// Drop recommendations which are outside of both windows.
+	var recommendations []timestampedRecommendation
+	for _, r := range n.recommendations[key] {
+		if r.timestamp.Before(longestCutoff) {
+			continue
+		}
+
+		if r.timestamp.After(upCutoff) {
+			upRecommendation = min(r.recommendation, upRecommendation)
+		}
+
+		if r.timestamp.After(downCutoff) {
+			downRecommendation = max(r.recommendation, downRecommendation)
+		}
+
+		recommendations = append(recommendations, r)
+	}
+
+	n.recommendations[key] = append(recommendations, timestampedRecommendation{
+		recommendation: desiredReplicas,
+		timestamp:      now,
+	})
+
+	recommendation := currentReplicas
+	if recommendation < upRecommendation {
+		recommendation = upRecommendation
+	}
+
+	if recommendation > downRecommendation {
+		recommendation = downRecommendation
+	}
+
+	return recommendation
+}
+
+// storeScaleEvent records a change in the number of instances, and removes any
+// events which are outside of the longest policy period.
+func (n *BehaviorNormalizer) storeScaleEvent(
+	key string,
+	now time.Time,
+	scaleUpRules v1alpha.HorizontalScalingRules,
+	scaleDownRules v1alpha.HorizontalScalingRules,
+	previousReplicas int32,
+	newReplicas int32,
+) {
+	n.scaleUpEvents[key] = pruneScaleEvents(n.scaleUpEvents[key], now, getLongestPolicyPeriod(scaleUpRules))
+	n.scaleDownEvents[key] = pruneScaleEvents(n.scaleDownEvents[key], now, getLongestPolicyPeriod(scaleDownRules))
+
+	if newReplicas > previousReplicas {
+		n.scaleUpEvents[key] = append(n.scaleUpEvents[key], timestampedScaleEvent{
+			replicaChange: newReplicas - previousReplicas,
+			timestamp:     now,
+		})
+	} else {
+		n.scaleDownEvents[key] = append(n.scaleDownEvents[key], timestampedScaleEvent{
+			replicaChange: previousReplicas - newReplicas,
+			timestamp:     now,
+		})
+	}
+}
+
+func pruneScaleEvents(events []timestampedScaleEvent, now time.Time, periodSeconds int32) []timestampedScaleEvent {
+	cutoff := now.Add(-time.Duration(periodSeconds) * time.Second)
+
+	var result []timestampedScaleEvent
+	for _, e := range events {
+		if e.timestamp.After(cutoff) {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
+func getLongestPolicyPeriod(rules v1alpha.HorizontalScalingRules) int32 {
+	var longestPolicyPeriod int32
+	for _, policy := range rules.Policies {
+		longestPolicyPeriod = max(longestPolicyPeriod, policy.PeriodSeconds)
+	}
+	return longestPolicyPeriod
+}
+
+// getReplicasChangePerPeriod returns the total change in the number of
+// instances from events within the period.
+func getReplicasChangePerPeriod(periodSeconds int32, events []timestampedScaleEvent, now time.Time) int32 {
+	cutoff := now.Add(-time.Duration(periodSeconds) * time.Second)
+
+	var replicas int32
+	for _, e := range events {
+		if e.timestamp.After(cutoff) {
+			replicas += e.replicaChange
+		}
+	}
+
+	return replicas
+}
+
+// calculateScaleUpLimit returns the maximum number of instances that may exist
+// after scaling up, as permitted by the scaling policies.
+func calculateScaleUpLimit(
+	currentReplicas int32,
+	scaleUpEvents []timestampedScaleEvent,
+	scaleDownEvents []timestampedScaleEvent,
+	now time.Time,
+	rules v1alpha.HorizontalScalingRules,
+) int32 {
+	var result int32
+	var selectPolicyFn func(int32, int32) int32
+	switch *rules.SelectPolicy {
+	case v1alpha.DisabledPolicySelect:
+		return currentReplicas
+	case v1alpha.MinChangePolicySelect:
+		result = math.MaxInt32
+		selectPolicyFn = minInt32
+	default:
+		result = math.MinInt32
+		selectPolicyFn = maxInt32
+	}
+
+	for _, policy := range rules.Policies {
+		replicasAddedInCurrentPeriod := getReplicasChangePerPeriod(policy.PeriodSeconds, scaleUpEvents, now)
+		replicasDeletedInCurrentPeriod := getReplicasChangePerPeriod(policy.PeriodSeconds, scaleDownEvents, now)
+		periodStartReplicas := currentReplicas - replicasAddedInCurrentPeriod + replicasDeletedInCurrentPeriod
+
+		var proposed int32
+		switch policy.Type {
+		case v1alpha.InstancesScalingPolicy:
+			proposed = periodStartReplicas + policy.Value
+		case v1alpha.PercentScalingPolicy:
+			// The proposal has to be rounded up, otherwise a small number of
+			// instances may never be able to scale up.
+			proposed = int32(math.Ceil(float64(periodStartReplicas) * (1 + float64(policy.Value)/100)))
+		}
+		result = selectPolicyFn(result, proposed)
+	}
+
+	return result
+}
+
+// calculateScaleDownLimit returns the minimum number of instances that may
+// exist after scaling down, as permitted by the scaling policies.
+func calculateScaleDownLimit(
+	currentReplicas int32,
+	scaleUpEvents []timestampedScaleEvent,
+	scaleDownEvents []timestampedScaleEvent,
+	now time.Time,
+	rules v1alpha.HorizontalScalingRules,
+) int32 {
+	var result int32
+	var selectPolicyFn func(int32, int32) int32
+	switch *rules.SelectPolicy {
+	case v1alpha.DisabledPolicySelect:
+		return currentReplicas
+	case v1alpha.MinChangePolicySelect:
+		result = math.MinInt32
+		selectPolicyFn = maxInt32
+	default:
+		result = math.MaxInt32
+		selectPolicyFn = minInt32
+	}
+
+	for _, policy := range rules.Policies {
+		replicasAddedInCurrentPeriod := getReplicasChangePerPeriod(policy.PeriodSeconds, scaleUpEvents, now)
+		replicasDeletedInCurrentPeriod := getReplicasChangePerPeriod(policy.PeriodSeconds, scaleDownEvents, now)
+		periodStartReplicas := currentReplicas - replicasAddedInCurrentPeriod + replicasDeletedInCurrentPeriod
+
+		var proposed int32
+		switch policy.Type {
+		case v1alpha.InstancesScalingPolicy:
+			proposed = periodStartReplicas - policy.Value
+		case v1alpha.PercentScalingPolicy:
+			proposed = int32(float64(periodStartReplicas) * (1 - float64(policy.Value)/100))
+		}
+		result = selectPolicyFn(result, proposed)
+	}
+
+	return result
+}
+
+func minInt32(a, b int32) int32 {
+	return min(a, b)
+}
+
+func maxInt32(a, b int32) int32 {
+	return max(a, b)
+}
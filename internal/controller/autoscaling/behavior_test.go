@@ -0,0 +1,128 @@
+package autoscaling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestScaleDownStabilization(t *testing.T) {
+	normalizer, clock := getBehaviorNormalizer()
+
+	scaleSettings := v1alpha.HorizontalScaleSettings{
+		MinReplicas: 1,
+		MaxReplicas: ptr.To(int32(10)),
+	}
+
+	assert.Equal(t, int32(5), normalizer.Normalize("test", scaleSettings, 5, 5))
+
+	// Recommendations within the default 300 second window prevent scaling down.
+	*clock = clock.Add(60 * time.Second)
+	assert.Equal(t, int32(5), normalizer.Normalize("test", scaleSettings, 5, 2))
+
+	*clock = clock.Add(120 * time.Second)
+	assert.Equal(t, int32(5), normalizer.Normalize("test", scaleSettings, 5, 2))
+
+	// The recommendation for 5 instances is now outside of the window.
+	*clock = clock.Add(121 * time.Second)
+	assert.Equal(t, int32(2), normalizer.Normalize("test", scaleSettings, 5, 2))
+}
+
+func TestScaleUpRateLimit(t *testing.T) {
+	normalizer, clock := getBehaviorNormalizer()
+
+	scaleSettings := v1alpha.HorizontalScaleSettings{
+		MinReplicas: 1,
+		MaxReplicas: ptr.To(int32(20)),
+	}
+
+	// By default, the larger of 4 instances or 100% is permitted per 15 seconds.
+	assert.Equal(t, int32(6), normalizer.Normalize("test", scaleSettings, 2, 20))
+
+	// The scale up already performed within the period counts against the
+	// limit.
+	*clock = clock.Add(5 * time.Second)
+	assert.Equal(t, int32(6), normalizer.Normalize("test", scaleSettings, 6, 20))
+
+	*clock = clock.Add(11 * time.Second)
+	assert.Equal(t, int32(12), normalizer.Normalize("test", scaleSettings, 6, 20))
+
+	// Never exceed the maximum replicas.
+	*clock = clock.Add(16 * time.Second)
+	assert.Equal(t, int32(20), normalizer.Normalize("test", scaleSettings, 12, 30))
+}
+
+func TestScaleUpMinChangePolicy(t *testing.T) {
+	normalizer, _ := getBehaviorNormalizer()
+
+	scaleSettings := v1alpha.HorizontalScaleSettings{
+		MinReplicas: 1,
+		MaxReplicas: ptr.To(int32(100)),
+		Behavior: &v1alpha.HorizontalScalingBehavior{
+			ScaleUp: &v1alpha.HorizontalScalingRules{
+				SelectPolicy: ptr.To(v1alpha.MinChangePolicySelect),
+				Policies: []v1alpha.HorizontalScalingPolicy{
+					{
+						Type:          v1alpha.InstancesScalingPolicy,
+						Value:         2,
+						PeriodSeconds: 60,
+					},
+					{
+						Type:          v1alpha.PercentScalingPolicy,
+						Value:         50,
+						PeriodSeconds: 60,
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, int32(12), normalizer.Normalize("test", scaleSettings, 10, 50))
+}
+
+func TestScaleDownDisabled(t *testing.T) {
+	normalizer, _ := getBehaviorNormalizer()
+
+	scaleSettings := v1alpha.HorizontalScaleSettings{
+		MinReplicas: 1,
+		MaxReplicas: ptr.To(int32(10)),
+		Behavior: &v1alpha.HorizontalScalingBehavior{
+			ScaleDown: &v1alpha.HorizontalScalingRules{
+				StabilizationWindowSeconds: ptr.To(int32(0)),
+				SelectPolicy:               ptr.To(v1alpha.DisabledPolicySelect),
+			},
+		},
+	}
+
+	assert.Equal(t, int32(8), normalizer.Normalize("test", scaleSettings, 8, 2))
+}
+
+func TestForget(t *testing.T) {
+	normalizer, _ := getBehaviorNormalizer()
+
+	scaleSettings := v1alpha.HorizontalScaleSettings{
+		MinReplicas: 1,
+		MaxReplicas: ptr.To(int32(10)),
+	}
+
+	assert.Equal(t, int32(5), normalizer.Normalize("test", scaleSettings, 5, 5))
+	assert.Equal(t, int32(5), normalizer.Normalize("test", scaleSettings, 5, 2))
+
+	normalizer.Forget("test")
+
+	assert.Equal(t, int32(2), normalizer.Normalize("test", scaleSettings, 5, 2))
+}
+
+func getBehaviorNormalizer() (*BehaviorNormalizer, *time.Time) {
+	clock := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	normalizer := NewBehaviorNormalizer()
+	normalizer.now = func() time.Time {
+		return clock
+	}
+
+	return normalizer, &clock
+}
@@ -0,0 +1,151 @@
+package autoscaling
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	mccontext "sigs.k8s.io/multicluster-runtime/pkg/context"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/config"
+)
+
+// PrometheusMetricsSource reads instance metrics from a Prometheus compatible
+// API.
+type PrometheusMetricsSource struct {
+	api              promv1.API
+	cpuUsageQuery    *template.Template
+	cpuCapacityQuery *template.Template
+	instanceLabel    model.LabelName
+}
+
+var _ MetricsSource = &PrometheusMetricsSource{}
+
+func NewPrometheusMetricsSource(cfg config.PrometheusMetricsSourceConfig) (*PrometheusMetricsSource, error) {
+	client, err := promapi.NewClient(promapi.Config{
+		Address: cfg.Address,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating prometheus client: %w", err)
+	}
+
+	cpuUsageQuery, err := template.New("cpuUsageQuery").Parse(cfg.CPUUsageQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing cpu usage query: %w", err)
+	}
+
+	var cpuCapacityQuery *template.Template
+	if len(cfg.CPUCapacityQuery) > 0 {
+		cpuCapacityQuery, err = template.New("cpuCapacityQuery").Parse(cfg.CPUCapacityQuery)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing cpu capacity query: %w", err)
+		}
+	}
+
+	return &PrometheusMetricsSource{
+		api:              promv1.NewAPI(client),
+		cpuUsageQuery:    cpuUsageQuery,
+		cpuCapacityQuery: cpuCapacityQuery,
+		instanceLabel:    model.LabelName(cfg.InstanceLabel),
+	}, nil
+}
+
+type prometheusQueryData struct {
+	ClusterName string
+	Namespace   string
+	Deployment  string
+}
+
+func (s *PrometheusMetricsSource) GetResourceMetric(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	resourceName k8scorev1.ResourceName,
+) (InstanceMetrics, error) {
+	if resourceName != k8scorev1.ResourceCPU {
+		return nil, fmt.Errorf("unsupported resource %q", resourceName)
+	}
+
+	clusterName, _ := mccontext.ClusterFrom(ctx)
+	data := prometheusQueryData{
+		ClusterName: clusterName,
+		Namespace:   deployment.Namespace,
+		Deployment:  deployment.Name,
+	}
+
+	usage, err := s.query(ctx, s.cpuUsageQuery, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying cpu usage: %w", err)
+	}
+
+	// Capacity is optional, and only needed for utilization targets.
+	var capacity map[string]resource.Quantity
+	if s.cpuCapacityQuery != nil {
+		capacity, err = s.query(ctx, s.cpuCapacityQuery, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed querying cpu capacity: %w", err)
+		}
+	}
+
+	metrics := make(InstanceMetrics, len(usage))
+	for instanceName, value := range usage {
+		metric := InstanceMetric{
+			Usage: value,
+		}
+
+		if c, ok := capacity[instanceName]; ok {
+			metric.Capacity = &c
+		}
+
+		metrics[instanceName] = metric
+	}
+
+	return metrics, nil
+}
+
+func (s *PrometheusMetricsSource) query(
+	ctx context.Context,
+	queryTemplate *template.Template,
+	data prometheusQueryData,
+) (map[string]resource.Quantity, error) {
+	logger := log.FromContext(ctx)
+
+	var query bytes.Buffer
+	if err := queryTemplate.Execute(&query, data); err != nil {
+		return nil, fmt.Errorf("failed rendering query: %w", err)
+	}
+
+	result, warnings, err := s.api.Query(ctx, query.String(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(warnings) > 0 {
+		logger.Info("prometheus query returned warnings", "warnings", warnings)
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected query result type %q", result.Type())
+	}
+
+	values := make(map[string]resource.Quantity, len(vector))
+	for _, sample := range vector {
+		instanceName, ok := sample.Metric[s.instanceLabel]
+		if !ok {
+			continue
+		}
+
+		values[string(instanceName)] = *resource.NewMilliQuantity(int64(float64(sample.Value)*1000), resource.DecimalSI)
+	}
+
+	return values, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
 
@@ -15,6 +16,7 @@ import (
 const (
 	deploymentWorkloadUIDIndex = "deploymentWorkloadUIDIndex"
 	workloadNetworksIndex      = "workloadNetworksIndex"
+	workloadCityCodesIndex     = "workloadCityCodesIndex"
 	deploymentLocationIndex    = "deploymentLocationIndex"
 )
 
@@ -63,6 +65,11 @@ func addWorkloadIndexers(ctx context.Context, mgr mcmanager.Manager) error {
 		return fmt.Errorf("failed to add workload indexer %q: %w", workloadNetworksIndex, err)
 	}
 
+	// Index workloads by the city codes of their placements
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &computev1alpha.Workload{}, workloadCityCodesIndex, workloadCityCodesIndexFunc); err != nil {
+		return fmt.Errorf("failed to add workload indexer %q: %w", workloadCityCodesIndex, err)
+	}
+
 	return nil
 }
 
@@ -85,3 +92,23 @@ func workloadNetworksIndexFunc(o client.Object) []string {
 
 	return networks
 }
+
+// anyCityCode is the value indexed for workloads with placements that are not
+// limited to specific city codes.
+const anyCityCode = "*"
+
+func workloadCityCodesIndexFunc(o client.Object) []string {
+	workload := o.(*computev1alpha.Workload)
+
+	cityCodes := sets.Set[string]{}
+	for _, placement := range workload.Spec.Placements {
+		if len(placement.CityCodes) == 0 {
+			cityCodes.Insert(anyCityCode)
+			continue
+		}
+
+		cityCodes.Insert(placement.CityCodes...)
+	}
+
+	return sets.List(cityCodes)
+}
@@ -112,6 +112,22 @@ func (r *InstanceReconciler) reconcileInstanceReadyCondition(
 		return apimeta.SetStatusCondition(&instance.Status.Conditions, *readyCondition), nil
 	}
 
+	if instance.Spec.PowerState == computev1alpha.StoppedInstancePowerState {
+		// Instances which have been intentionally stopped are not ready, but
+		// have not failed.
+		runningCondition := apimeta.FindStatusCondition(instance.Status.Conditions, computev1alpha.InstanceRunning)
+		if runningCondition != nil && runningCondition.Status == metav1.ConditionTrue {
+			readyCondition.Reason = computev1alpha.InstanceRunningReasonStopping
+			readyCondition.Message = "Instance is stopping"
+		} else {
+			readyCondition.Reason = computev1alpha.InstanceRunningReasonStopped
+			readyCondition.Message = "Instance has been stopped"
+		}
+
+		readyCondition.Status = metav1.ConditionFalse
+		return apimeta.SetStatusCondition(&instance.Status.Conditions, *readyCondition), nil
+	}
+
 	pendingReason := "Pending"
 	programmedCondition := apimeta.FindStatusCondition(instance.Status.Conditions, computev1alpha.InstanceProgrammed)
 	if programmedCondition == nil || programmedCondition.Status != metav1.ConditionTrue {
@@ -146,9 +162,31 @@ func (r *InstanceReconciler) reconcileInstanceReadyCondition(
 			readyCondition.Message = runningCondition.Message
 		}
 
+		if reason, message, ok := getInitContainerProgress(instance); ok {
+			readyCondition.Reason = reason
+			readyCondition.Message = message
+		}
+
 		return apimeta.SetStatusCondition(&instance.Status.Conditions, *readyCondition), nil
 	}
 
+	if hasReadinessProbes(instance.Spec) {
+		probesCondition := apimeta.FindStatusCondition(instance.Status.Conditions, computev1alpha.InstanceProbesReady)
+		if probesCondition == nil || probesCondition.Status != metav1.ConditionTrue {
+			logger.Info("instance probes are not ready", "instance", instance.Name)
+
+			readyCondition.Status = metav1.ConditionFalse
+			readyCondition.Reason = computev1alpha.InstanceProbesReadyReasonProbesNotReady
+			readyCondition.Message = "Readiness probes have not succeeded"
+			if probesCondition != nil && probesCondition.Status != metav1.ConditionUnknown {
+				readyCondition.Reason = probesCondition.Reason
+				readyCondition.Message = probesCondition.Message
+			}
+
+			return apimeta.SetStatusCondition(&instance.Status.Conditions, *readyCondition), nil
+		}
+	}
+
 	readyCondition.Status = metav1.ConditionTrue
 	readyCondition.Reason = computev1alpha.InstanceReadyReasonRunning
 	readyCondition.Message = "Instance is ready"
@@ -156,6 +194,77 @@ func (r *InstanceReconciler) reconcileInstanceReadyCondition(
 	return apimeta.SetStatusCondition(&instance.Status.Conditions, *readyCondition), nil
 }
 
+// getInitContainerProgress returns the reason and message describing the
+// progress of the instance's init containers, as reported in the instance's
+// status. Returns false if all init containers have completed, or sidecar
+// containers are running.
+func getInitContainerProgress(instance *computev1alpha.Instance) (string, string, bool) {
+	sandbox := instance.Spec.Runtime.Sandbox
+	if sandbox == nil || len(sandbox.InitContainers) == 0 {
+		return "", "", false
+	}
+
+	statuses := make(map[string]computev1alpha.InitContainerStatus, len(instance.Status.InitContainers))
+	for _, status := range instance.Status.InitContainers {
+		statuses[status.Name] = status
+	}
+
+	for i, container := range sandbox.InitContainers {
+		status, ok := statuses[container.Name]
+		if !ok {
+			status.State = computev1alpha.WaitingInitContainerState
+		}
+
+		switch status.State {
+		case computev1alpha.CompletedInitContainerState:
+			continue
+		case computev1alpha.RunningInitContainerState:
+			if container.RestartPolicy != nil {
+				continue
+			}
+		case computev1alpha.FailedInitContainerState:
+			message := fmt.Sprintf("Init container %q failed", container.Name)
+			if status.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, status.Message)
+			}
+			return computev1alpha.InstanceReadyReasonInitContainerFailed, message, true
+		}
+
+		return computev1alpha.InstanceReadyReasonInitializing, fmt.Sprintf(
+			"Waiting for init container %q (%d/%d init containers ready)",
+			container.Name, i, len(sandbox.InitContainers),
+		), true
+	}
+
+	return "", "", false
+}
+
+// hasReadinessProbes returns true if the instance defines probes which must
+// succeed before the instance is ready.
+func hasReadinessProbes(spec computev1alpha.InstanceSpec) bool {
+	if sandbox := spec.Runtime.Sandbox; sandbox != nil {
+		for _, container := range sandbox.Containers {
+			if container.ReadinessProbe != nil || container.StartupProbe != nil {
+				return true
+			}
+		}
+
+		// Only sidecar containers may be probed, as other init containers run to
+		// completion before the instance's containers are started.
+		for _, container := range sandbox.InitContainers {
+			if container.ReadinessProbe != nil || container.StartupProbe != nil {
+				return true
+			}
+		}
+	}
+
+	if vm := spec.Runtime.VirtualMachine; vm != nil {
+		return vm.ReadinessProbe != nil || vm.StartupProbe != nil
+	}
+
+	return false
+}
+
 // Rough way to propagate creation errors up to the instance as soon as possible.
 // Lots of room for improvement here.
 func (r *InstanceReconciler) checkForNetworkCreationFailure(ctx context.Context, upstreamClient client.Client, instance *computev1alpha.Instance) (failed bool, message string, err error) {
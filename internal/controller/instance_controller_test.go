@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
@@ -167,6 +169,103 @@ func TestReconcileInstanceReadyCondition(t *testing.T) {
 				ObservedGeneration: 1,
 			},
 		},
+		{
+			name: "instance with incomplete init containers should report progress",
+			instance: &computev1alpha.Instance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-instance",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: computev1alpha.InstanceSpec{
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Sandbox: &computev1alpha.SandboxRuntime{
+							InitContainers: []computev1alpha.SandboxContainer{
+								{
+									Name:          "proxy",
+									RestartPolicy: ptr.To(computev1alpha.AlwaysContainerRestartPolicy),
+								},
+								{Name: "migrate"},
+								{Name: "fetch-secrets"},
+							},
+						},
+					},
+				},
+				Status: computev1alpha.InstanceStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    computev1alpha.InstanceProgrammed,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceProgrammedReasonProgrammed,
+							Message: "Instance has been programmed",
+						},
+						{
+							Type:    computev1alpha.InstanceRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  computev1alpha.InstanceRunningReasonStarting,
+							Message: "Instance is starting",
+						},
+					},
+					InitContainers: []computev1alpha.InitContainerStatus{
+						{Name: "proxy", State: computev1alpha.RunningInitContainerState},
+						{Name: "migrate", State: computev1alpha.RunningInitContainerState},
+					},
+				},
+			},
+			expectedChanged: true,
+			expectedCondition: &metav1.Condition{
+				Type:               computev1alpha.InstanceReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             computev1alpha.InstanceReadyReasonInitializing,
+				Message:            `Waiting for init container "migrate" (1/3 init containers ready)`,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name: "instance with failed init container should report failure",
+			instance: &computev1alpha.Instance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-instance",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: computev1alpha.InstanceSpec{
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Sandbox: &computev1alpha.SandboxRuntime{
+							InitContainers: []computev1alpha.SandboxContainer{
+								{Name: "migrate"},
+							},
+						},
+					},
+				},
+				Status: computev1alpha.InstanceStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    computev1alpha.InstanceProgrammed,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceProgrammedReasonProgrammed,
+							Message: "Instance has been programmed",
+						},
+					},
+					InitContainers: []computev1alpha.InitContainerStatus{
+						{
+							Name:     "migrate",
+							State:    computev1alpha.FailedInitContainerState,
+							ExitCode: ptr.To(int32(1)),
+							Message:  "exit code 1",
+						},
+					},
+				},
+			},
+			expectedChanged: true,
+			expectedCondition: &metav1.Condition{
+				Type:               computev1alpha.InstanceReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             computev1alpha.InstanceReadyReasonInitContainerFailed,
+				Message:            `Init container "migrate" failed: exit code 1`,
+				ObservedGeneration: 1,
+			},
+		},
 		{
 			name: "instance fully ready should set ready condition",
 			instance: &computev1alpha.Instance{
@@ -201,6 +300,146 @@ func TestReconcileInstanceReadyCondition(t *testing.T) {
 				ObservedGeneration: 1,
 			},
 		},
+		{
+			name: "instance with failing readiness probe should not be ready",
+			instance: &computev1alpha.Instance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-instance",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: computev1alpha.InstanceSpec{
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Sandbox: &computev1alpha.SandboxRuntime{
+							Containers: []computev1alpha.SandboxContainer{
+								{
+									Name: "app",
+									ReadinessProbe: &computev1alpha.Probe{
+										ProbeHandler: computev1alpha.ProbeHandler{
+											TCPSocket: &computev1alpha.TCPSocketAction{
+												Port: intstr.FromInt32(8080),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Status: computev1alpha.InstanceStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               computev1alpha.InstanceReady,
+							Status:             metav1.ConditionTrue,
+							Reason:             computev1alpha.InstanceReadyReasonRunning,
+							Message:            "Instance is ready",
+							ObservedGeneration: 1,
+							LastTransitionTime: metav1.Now(),
+						},
+						{
+							Type:    computev1alpha.InstanceProgrammed,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceProgrammedReasonProgrammed,
+							Message: "Instance has been programmed",
+						},
+						{
+							Type:    computev1alpha.InstanceRunning,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceRunningReasonRunning,
+							Message: "Instance is running",
+						},
+						{
+							Type:    computev1alpha.InstanceProbesReady,
+							Status:  metav1.ConditionFalse,
+							Reason:  "ReadinessProbeFailed",
+							Message: "Readiness probe of container app failed",
+						},
+					},
+				},
+			},
+			expectedChanged: true,
+			expectedCondition: &metav1.Condition{
+				Type:               computev1alpha.InstanceReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "ReadinessProbeFailed",
+				Message:            "Readiness probe of container app failed",
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name: "stopping instance should not be ready",
+			instance: &computev1alpha.Instance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-instance",
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Spec: computev1alpha.InstanceSpec{
+					PowerState: computev1alpha.StoppedInstancePowerState,
+				},
+				Status: computev1alpha.InstanceStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    computev1alpha.InstanceProgrammed,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceProgrammedReasonProgrammed,
+							Message: "Instance has been programmed",
+						},
+						{
+							Type:    computev1alpha.InstanceRunning,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceRunningReasonRunning,
+							Message: "Instance is running",
+						},
+					},
+				},
+			},
+			expectedChanged: true,
+			expectedCondition: &metav1.Condition{
+				Type:               computev1alpha.InstanceReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             computev1alpha.InstanceRunningReasonStopping,
+				Message:            "Instance is stopping",
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name: "stopped instance should not be ready",
+			instance: &computev1alpha.Instance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-instance",
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Spec: computev1alpha.InstanceSpec{
+					PowerState: computev1alpha.StoppedInstancePowerState,
+				},
+				Status: computev1alpha.InstanceStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:    computev1alpha.InstanceProgrammed,
+							Status:  metav1.ConditionTrue,
+							Reason:  computev1alpha.InstanceProgrammedReasonProgrammed,
+							Message: "Instance has been programmed",
+						},
+						{
+							Type:    computev1alpha.InstanceRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  computev1alpha.InstanceRunningReasonStopped,
+							Message: "Instance is stopped",
+						},
+					},
+				},
+			},
+			expectedChanged: true,
+			expectedCondition: &metav1.Condition{
+				Type:               computev1alpha.InstanceReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             computev1alpha.InstanceRunningReasonStopped,
+				Message:            "Instance has been stopped",
+				ObservedGeneration: 2,
+			},
+		},
 		{
 			name: "no change when condition already matches",
 			instance: &computev1alpha.Instance{
@@ -5,8 +5,11 @@ import (
 	"hash"
 	"hash/fnv"
 
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/dump"
 	"k8s.io/apimachinery/pkg/util/rand"
+
+	"go.datum.net/workload-operator/api/v1alpha"
 )
 
 // ComputeHash returns a hash value calculated from pod template and
@@ -28,3 +31,80 @@ func DeepHashObject(hasher hash.Hash, objectToWrite interface{}) {
 	hasher.Reset()
 	_, _ = fmt.Fprintf(hasher, "%v", dump.ForHash(objectToWrite))
 }
+
+// DesiredReplicas returns the number of instances which should exist for the
+// deployment. When the deployment is autoscaled, the desired replicas
+// calculated by the autoscaler are used, bounded by the deployment's scale
+// settings. Otherwise, the minimum number of replicas is used.
+//
+// No instances are desired while the deployment is being drained from its
+// location.
+func DesiredReplicas(deployment *v1alpha.WorkloadDeployment) int32 {
+	if Draining(deployment) {
+		return 0
+	}
+
+	scaleSettings := deployment.Spec.ScaleSettings
+	autoscaling := deployment.Status.Autoscaling
+	if scaleSettings.MaxReplicas == nil || autoscaling == nil {
+		return scaleSettings.MinReplicas
+	}
+
+	return max(scaleSettings.MinReplicas, min(autoscaling.DesiredReplicas, *scaleSettings.MaxReplicas))
+}
+
+// Draining returns true if the deployment is being moved away from its
+// location, and its instances should be removed.
+func Draining(deployment *v1alpha.WorkloadDeployment) bool {
+	return deployment.Status.Location != nil &&
+		apimeta.IsStatusConditionTrue(deployment.Status.Conditions, v1alpha.WorkloadDeploymentRescheduling)
+}
+
+// DesiredPowerState returns the power state of the deployment's instances.
+// Instances are stopped while the deployment is suspended. Otherwise, the power
+// state in the deployment's instance template is used.
+func DesiredPowerState(deployment *v1alpha.WorkloadDeployment) v1alpha.InstancePowerState {
+	if deployment.Spec.Suspend {
+		return v1alpha.StoppedInstancePowerState
+	}
+
+	return PowerState(deployment.Spec.Template.Spec)
+}
+
+// PowerState returns the power state of the instance spec, which defaults to
+// Running.
+func PowerState(spec v1alpha.InstanceSpec) v1alpha.InstancePowerState {
+	if spec.PowerState == "" {
+		return v1alpha.RunningInstancePowerState
+	}
+
+	return spec.PowerState
+}
+
+// RestartPending returns true if the deployment's restart request has not yet
+// been handled for the instance. Instances created while the request is in
+// effect record it at creation, and are not restarted again.
+func RestartPending(deployment *v1alpha.WorkloadDeployment, instance *v1alpha.Instance) bool {
+	request := deployment.Spec.Restart
+	if request == nil {
+		return false
+	}
+
+	controller := instance.Spec.Controller
+	if controller != nil && controller.RestartRequestedAt != nil {
+		return controller.RestartRequestedAt.Before(&request.RequestedAt)
+	}
+
+	return instance.CreationTimestamp.Before(&request.RequestedAt)
+}
+
+// Restarting returns true if the instance has been requested to restart, and
+// the restart has not yet been completed by its infrastructure provider.
+func Restarting(instance *v1alpha.Instance) bool {
+	if instance.Spec.Controller == nil || instance.Spec.Controller.RestartedAt == nil {
+		return false
+	}
+
+	status := instance.Status.Controller
+	return status == nil || !status.ObservedRestartedAt.Equal(instance.Spec.Controller.RestartedAt)
+}
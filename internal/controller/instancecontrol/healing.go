@@ -0,0 +1,268 @@
+package instancecontrol
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+const (
+	// DefaultUnhealthyThresholdSeconds is the number of seconds an instance may
+	// remain unhealthy before it is repaired when not provided in the auto
+	// healing policy.
+	DefaultUnhealthyThresholdSeconds = 300
+
+	// DefaultInitialBackoffSeconds is the number of seconds to wait after the
+	// first repair of an instance when not provided in the auto healing policy.
+	DefaultInitialBackoffSeconds = 60
+
+	// DefaultMaxBackoffSeconds is the maximum number of seconds to wait between
+	// repairs of an instance when not provided in the auto healing policy.
+	DefaultMaxBackoffSeconds = 3600
+)
+
+// HealingResult is the outcome of planning the repair of unhealthy instances.
+type HealingResult struct {
+	// Actions are the delete actions for instances which should be repaired.
+	// The deployment's strategy will create the instances again once they have
+	// been removed.
+	Actions []Action
+
+	// Status is the healing status to be recorded on the deployment.
+	Status []v1alpha.InstanceHealingStatus
+
+	// RequeueAfter is the duration after which healing should be planned again,
+	// such as when an instance has not been unhealthy for long enough, or is
+	// waiting for its backoff to expire.
+	RequeueAfter time.Duration
+}
+
+// PlanHealing determines which of the deployment's instances have been
+// unhealthy for longer than the threshold in the deployment's auto healing
+// policy, and should be deleted so that they are created again.
+//
+// Instances are repaired according to the deployment's instance management
+// policy. When instances are managed with the OrderedReady policy, only the
+// unhealthy instance with the lowest ordinal is repaired, and only when no
+// other instances are being deleted.
+//
+// Consecutive repairs of an instance are delayed by an exponential backoff.
+// An instance's healing status is removed once it has remained ready for the
+// unhealthy threshold, so that instances which repeatedly become ready and
+// then fail again continue to back off.
+//
+// PlanHealing returns nil when the deployment does not have an auto healing
+// policy.
+func PlanHealing(
+	deployment *v1alpha.WorkloadDeployment,
+	instances []v1alpha.Instance,
+	now time.Time,
+) *HealingResult {
+	policy := deployment.Spec.ScaleSettings.AutoHealing
+	if policy == nil {
+		return nil
+	}
+
+	threshold := time.Duration(ptr.Deref(policy.UnhealthyThresholdSeconds, DefaultUnhealthyThresholdSeconds)) * time.Second
+	initialBackoff := time.Duration(ptr.Deref(policy.InitialBackoffSeconds, DefaultInitialBackoffSeconds)) * time.Second
+	maxBackoff := time.Duration(ptr.Deref(policy.MaxBackoffSeconds, DefaultMaxBackoffSeconds)) * time.Second
+
+	result := &HealingResult{}
+	requeueAfter := func(d time.Duration) {
+		if result.RequeueAfter == 0 || d < result.RequeueAfter {
+			result.RequeueAfter = d
+		}
+	}
+
+	// Instances are considered in order of their ordinal, so that the lowest
+	// ordinal is repaired first.
+	sorted := make([]*v1alpha.Instance, len(instances))
+	for i := range instances {
+		sorted[i] = &instances[i]
+	}
+	slices.SortStableFunc(sorted, func(a, b *v1alpha.Instance) int {
+		return getInstanceIndex(a) - getInstanceIndex(b)
+	})
+
+	deleting := slices.ContainsFunc(sorted, func(instance *v1alpha.Instance) bool {
+		return !instance.DeletionTimestamp.IsZero()
+	})
+	orderedReady := deployment.Spec.ScaleSettings.InstanceManagementPolicy == v1alpha.OrderedReadyInstanceManagementPolicyType
+
+	// Retain the status of instances which have been repaired and are waiting
+	// to be created again, as long as they are still desired.
+	existingInstances := make(map[string]bool, len(instances))
+	for _, instance := range instances {
+		existingInstances[instance.Name] = true
+	}
+	desiredReplicas := int(DesiredReplicas(deployment))
+	for _, status := range deployment.Status.Healing {
+		if existingInstances[status.Name] {
+			continue
+		}
+
+		for i := 0; i < desiredReplicas; i++ {
+			if status.Name == fmt.Sprintf("%s-%d", deployment.Name, i) {
+				result.Status = append(result.Status, status)
+				break
+			}
+		}
+	}
+
+	for _, instance := range sorted {
+		var status *v1alpha.InstanceHealingStatus
+		if i := slices.IndexFunc(deployment.Status.Healing, func(s v1alpha.InstanceHealingStatus) bool {
+			return s.Name == instance.Name
+		}); i != -1 {
+			status = deployment.Status.Healing[i].DeepCopy()
+		}
+
+		if readyCondition := apimeta.FindStatusCondition(instance.Status.Conditions, v1alpha.InstanceReady); readyCondition != nil &&
+			readyCondition.Status == metav1.ConditionTrue {
+			// The status of a repaired instance is retained until the instance has
+			// remained ready for the threshold.
+			if status != nil {
+				if elapsed := now.Sub(readyCondition.LastTransitionTime.Time); elapsed < threshold {
+					requeueAfter(threshold - elapsed)
+					result.Status = append(result.Status, *status)
+				}
+			}
+			continue
+		}
+
+		reason, message, unhealthySince, unhealthy := getUnhealthyCondition(instance)
+		repair := unhealthy
+		if unhealthy {
+			if elapsed := now.Sub(unhealthySince); elapsed < threshold {
+				requeueAfter(threshold - elapsed)
+				repair = false
+			} else if status != nil {
+				backoff := getHealingBackoff(status.Attempts, initialBackoff, maxBackoff)
+				if elapsed := now.Sub(status.LastAttemptTime.Time); elapsed < backoff {
+					requeueAfter(backoff - elapsed)
+					repair = false
+				}
+			}
+		}
+
+		if repair && orderedReady && (deleting || len(result.Actions) > 0) {
+			repair = false
+		}
+
+		if repair {
+			if status == nil {
+				status = &v1alpha.InstanceHealingStatus{
+					Name: instance.Name,
+				}
+			}
+			status.Attempts++
+			status.LastAttemptTime = metav1.Time{Time: now}
+			status.Reason = reason
+			status.Message = message
+
+			result.Actions = append(result.Actions, NewDeleteAction(instance))
+		}
+
+		if status != nil {
+			result.Status = append(result.Status, *status)
+		}
+	}
+
+	slices.SortFunc(result.Status, func(a, b v1alpha.InstanceHealingStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+// getUnhealthyCondition determines whether the instance is unhealthy, and
+// returns the reason and message of the condition which caused it to become
+// unhealthy, along with the time it became unhealthy.
+//
+// An instance is unhealthy when it is not ready after having started running,
+// or when programming the instance has failed. Instances which are being
+// deleted, are waiting on scheduling gates, have been stopped, or are
+// restarting are not considered unhealthy.
+func getUnhealthyCondition(instance *v1alpha.Instance) (string, string, time.Time, bool) {
+	if !instance.DeletionTimestamp.IsZero() ||
+		(instance.Spec.Controller != nil && len(instance.Spec.Controller.SchedulingGates) > 0) ||
+		PowerState(instance.Spec) == v1alpha.StoppedInstancePowerState ||
+		Restarting(instance) {
+		return "", "", time.Time{}, false
+	}
+
+	programmedCondition := apimeta.FindStatusCondition(instance.Status.Conditions, v1alpha.InstanceProgrammed)
+	if programmedCondition == nil {
+		return "", "", time.Time{}, false
+	}
+
+	if programmedCondition.Status == metav1.ConditionFalse {
+		switch programmedCondition.Reason {
+		case v1alpha.InstanceProgrammedReasonPendingProgramming,
+			v1alpha.InstanceProgrammedReasonProgrammingInProgress,
+			"Pending":
+			return "", "", time.Time{}, false
+		}
+
+		return programmedCondition.Reason, programmedCondition.Message, programmedCondition.LastTransitionTime.Time, true
+	}
+
+	runningCondition := apimeta.FindStatusCondition(instance.Status.Conditions, v1alpha.InstanceRunning)
+	if runningCondition == nil || runningCondition.Status == metav1.ConditionUnknown {
+		return "", "", time.Time{}, false
+	}
+
+	if runningCondition.Status == metav1.ConditionFalse {
+		switch runningCondition.Reason {
+		case v1alpha.InstanceRunningReasonStarting,
+			v1alpha.InstanceRunningReasonStopping,
+			v1alpha.InstanceRunningReasonStopped,
+			"Pending":
+			return "", "", time.Time{}, false
+		}
+	}
+
+	readyCondition := apimeta.FindStatusCondition(instance.Status.Conditions, v1alpha.InstanceReady)
+	if readyCondition == nil || readyCondition.Status != metav1.ConditionFalse {
+		return "", "", time.Time{}, false
+	}
+
+	// Instances are given until the threshold to become ready after they have
+	// started running.
+	unhealthySince := readyCondition.LastTransitionTime.Time
+	if runningCondition.LastTransitionTime.After(unhealthySince) {
+		unhealthySince = runningCondition.LastTransitionTime.Time
+	}
+
+	return readyCondition.Reason, readyCondition.Message, unhealthySince, true
+}
+
+// getHealingBackoff returns the duration to wait after the given number of
+// repair attempts before an instance may be repaired again.
+func getHealingBackoff(attempts int32, initialBackoff, maxBackoff time.Duration) time.Duration {
+	backoff := initialBackoff
+	for i := int32(1); i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
+
+// getInstanceIndex returns the index of the instance from its labels, or -1 if
+// the instance does not have an index.
+func getInstanceIndex(instance *v1alpha.Instance) int {
+	index, err := strconv.Atoi(instance.Labels[v1alpha.InstanceIndexLabel])
+	if err != nil {
+		return -1
+	}
+
+	return index
+}
@@ -0,0 +1,300 @@
+package instancecontrol
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestPlanHealingWithoutPolicy(t *testing.T) {
+	deployment := getHealingDeployment(v1alpha.ParallelInstanceManagementPolicyType, 1)
+	deployment.Spec.ScaleSettings.AutoHealing = nil
+
+	assert.Nil(t, PlanHealing(deployment, nil, time.Now()))
+}
+
+func TestPlanHealing(t *testing.T) {
+	now := time.Now()
+	deployment := getHealingDeployment(v1alpha.ParallelInstanceManagementPolicyType, 4)
+
+	instances := []v1alpha.Instance{
+		getHealingInstance(deployment, 0, now.Add(-time.Hour), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionTrue,
+		}),
+		getHealingInstance(deployment, 1, now.Add(-10*time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionFalse,
+			Reason: v1alpha.InstanceProbesReadyReasonProbesNotReady,
+		}),
+		getHealingInstance(deployment, 2, now.Add(-time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionFalse,
+			Reason: v1alpha.InstanceProbesReadyReasonProbesNotReady,
+		}),
+		getHealingInstance(deployment, 3, now.Add(-10*time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceProgrammed,
+			Status: metav1.ConditionFalse,
+			Reason: "ProgrammingFailed",
+		}),
+	}
+
+	result := PlanHealing(deployment, instances, now)
+	if assert.Len(t, result.Actions, 2) {
+		assert.Equal(t, "test-1", result.Actions[0].Object.GetName())
+		assert.Equal(t, ActionTypeDelete, result.Actions[0].ActionType())
+		assert.Equal(t, "test-3", result.Actions[1].Object.GetName())
+	}
+
+	if assert.Len(t, result.Status, 2) {
+		assert.Equal(t, "test-1", result.Status[0].Name)
+		assert.Equal(t, int32(1), result.Status[0].Attempts)
+		assert.Equal(t, v1alpha.InstanceProbesReadyReasonProbesNotReady, result.Status[0].Reason)
+		assert.Equal(t, "ProgrammingFailed", result.Status[1].Reason)
+	}
+
+	// Instance 2 has been unhealthy for one minute.
+	assert.Equal(t, 4*time.Minute, result.RequeueAfter)
+}
+
+func TestPlanHealingOrderedReady(t *testing.T) {
+	now := time.Now()
+	deployment := getHealingDeployment(v1alpha.OrderedReadyInstanceManagementPolicyType, 2)
+
+	var instances []v1alpha.Instance
+	for i := range 2 {
+		instances = append(instances, getHealingInstance(deployment, i, now.Add(-10*time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionFalse,
+			Reason: v1alpha.InstanceProbesReadyReasonProbesNotReady,
+		}))
+	}
+
+	result := PlanHealing(deployment, instances, now)
+	if assert.Len(t, result.Actions, 1) {
+		assert.Equal(t, "test-0", result.Actions[0].Object.GetName())
+	}
+
+	// No instances are repaired while another instance is being deleted.
+	instances[0].DeletionTimestamp = &metav1.Time{Time: now}
+	result = PlanHealing(deployment, instances, now)
+	assert.Empty(t, result.Actions)
+}
+
+func TestPlanHealingBackoff(t *testing.T) {
+	now := time.Now()
+	deployment := getHealingDeployment(v1alpha.ParallelInstanceManagementPolicyType, 2)
+	deployment.Status.Healing = []v1alpha.InstanceHealingStatus{
+		{
+			Name:            "test-0",
+			Attempts:        2,
+			LastAttemptTime: metav1.Time{Time: now.Add(-90 * time.Second)},
+		},
+		{
+			Name:            "test-1",
+			Attempts:        3,
+			LastAttemptTime: metav1.Time{Time: now.Add(-time.Hour)},
+		},
+		{
+			// Instances which are no longer desired are removed from the status.
+			Name:            "test-5",
+			Attempts:        1,
+			LastAttemptTime: metav1.Time{Time: now.Add(-time.Hour)},
+		},
+	}
+
+	instances := []v1alpha.Instance{
+		getHealingInstance(deployment, 0, now.Add(-10*time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionFalse,
+			Reason: v1alpha.InstanceProbesReadyReasonProbesNotReady,
+		}),
+		getHealingInstance(deployment, 1, now.Add(-10*time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionFalse,
+			Reason: v1alpha.InstanceProbesReadyReasonProbesNotReady,
+		}),
+	}
+
+	result := PlanHealing(deployment, instances, now)
+	if assert.Len(t, result.Actions, 1) {
+		assert.Equal(t, "test-1", result.Actions[0].Object.GetName())
+	}
+
+	if assert.Len(t, result.Status, 2) {
+		assert.Equal(t, int32(2), result.Status[0].Attempts)
+		assert.Equal(t, int32(4), result.Status[1].Attempts)
+	}
+
+	// The second attempt backs off for two minutes.
+	assert.Equal(t, 30*time.Second, result.RequeueAfter)
+
+	// The status is removed once the instance has been ready for the threshold.
+	instances[0].Status.Conditions[2].Status = metav1.ConditionTrue
+	result = PlanHealing(deployment, instances, now)
+	if assert.Len(t, result.Status, 1) {
+		assert.Equal(t, "test-1", result.Status[0].Name)
+	}
+}
+
+func TestPlanHealingFlappingInstance(t *testing.T) {
+	now := time.Now()
+	deployment := getHealingDeployment(v1alpha.ParallelInstanceManagementPolicyType, 1)
+	deployment.Status.Healing = []v1alpha.InstanceHealingStatus{
+		{
+			Name:            "test-0",
+			Attempts:        3,
+			LastAttemptTime: metav1.Time{Time: now.Add(-2 * time.Minute)},
+		},
+	}
+
+	// The instance became ready shortly after it was last repaired, and its
+	// status is retained until it has been ready for the threshold.
+	instances := []v1alpha.Instance{
+		getHealingInstance(deployment, 0, now.Add(-time.Minute), metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionTrue,
+		}),
+	}
+
+	result := PlanHealing(deployment, instances, now)
+	assert.Empty(t, result.Actions)
+	if assert.Len(t, result.Status, 1) {
+		assert.Equal(t, int32(3), result.Status[0].Attempts)
+	}
+	assert.Equal(t, 4*time.Minute, result.RequeueAfter)
+
+	// The instance fails again, and is not repaired until the backoff of the
+	// third attempt has expired.
+	deployment.Status.Healing = result.Status
+	instances[0] = getHealingInstance(deployment, 0, now.Add(-5*time.Minute), metav1.Condition{
+		Type:   v1alpha.InstanceReady,
+		Status: metav1.ConditionFalse,
+		Reason: v1alpha.InstanceProbesReadyReasonProbesNotReady,
+	})
+
+	result = PlanHealing(deployment, instances, now)
+	assert.Empty(t, result.Actions)
+	assert.Equal(t, 2*time.Minute, result.RequeueAfter)
+
+	result = PlanHealing(deployment, instances, now.Add(2*time.Minute))
+	if assert.Len(t, result.Actions, 1) && assert.Len(t, result.Status, 1) {
+		assert.Equal(t, int32(4), result.Status[0].Attempts)
+	}
+
+	// The status is removed once the instance has remained ready for the
+	// threshold.
+	instances[0] = getHealingInstance(deployment, 0, now.Add(-10*time.Minute), metav1.Condition{
+		Type:   v1alpha.InstanceReady,
+		Status: metav1.ConditionTrue,
+	})
+	result = PlanHealing(deployment, instances, now)
+	assert.Empty(t, result.Status)
+}
+
+func TestGetHealingBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, getHealingBackoff(1, time.Minute, time.Hour))
+	assert.Equal(t, 4*time.Minute, getHealingBackoff(3, time.Minute, time.Hour))
+	assert.Equal(t, time.Hour, getHealingBackoff(100, time.Minute, time.Hour))
+}
+
+func TestGetUnhealthyCondition(t *testing.T) {
+	now := time.Now()
+	deployment := getHealingDeployment(v1alpha.ParallelInstanceManagementPolicyType, 1)
+
+	// Instances which have not started running are not unhealthy.
+	instance := getHealingInstance(deployment, 0, now, metav1.Condition{
+		Type:   v1alpha.InstanceReady,
+		Status: metav1.ConditionFalse,
+	})
+	instance.Status.Conditions[1].Status = metav1.ConditionFalse
+	instance.Status.Conditions[1].Reason = v1alpha.InstanceRunningReasonStarting
+	_, _, _, unhealthy := getUnhealthyCondition(&instance)
+	assert.False(t, unhealthy)
+
+	// Instances which crash after running are unhealthy.
+	instance.Status.Conditions[1].Reason = "Crashed"
+	_, _, _, unhealthy = getUnhealthyCondition(&instance)
+	assert.True(t, unhealthy)
+
+	// Stopped instances are not unhealthy.
+	instance.Spec.PowerState = v1alpha.StoppedInstancePowerState
+	_, _, _, unhealthy = getUnhealthyCondition(&instance)
+	assert.False(t, unhealthy)
+
+	// Instances pending programming are not unhealthy.
+	instance = getHealingInstance(deployment, 0, now, metav1.Condition{
+		Type:   v1alpha.InstanceProgrammed,
+		Status: metav1.ConditionFalse,
+		Reason: v1alpha.InstanceProgrammedReasonPendingProgramming,
+	})
+	_, _, _, unhealthy = getUnhealthyCondition(&instance)
+	assert.False(t, unhealthy)
+}
+
+func getHealingDeployment(policy v1alpha.InstanceManagementPolicyType, replicas int32) *v1alpha.WorkloadDeployment {
+	return &v1alpha.WorkloadDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: v1alpha.WorkloadDeploymentSpec{
+			ScaleSettings: v1alpha.HorizontalScaleSettings{
+				MinReplicas:              replicas,
+				InstanceManagementPolicy: policy,
+				AutoHealing:              &v1alpha.AutoHealingPolicy{},
+			},
+		},
+	}
+}
+
+// getHealingInstance returns a running instance with the provided condition,
+// which last transitioned at the provided time.
+func getHealingInstance(
+	deployment *v1alpha.WorkloadDeployment,
+	index int,
+	transitionTime time.Time,
+	condition metav1.Condition,
+) v1alpha.Instance {
+	conditions := []metav1.Condition{
+		{
+			Type:               v1alpha.InstanceProgrammed,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: transitionTime.Add(-time.Hour)},
+		},
+		{
+			Type:               v1alpha.InstanceRunning,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: transitionTime.Add(-time.Hour)},
+		},
+		{
+			Type:               v1alpha.InstanceReady,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Time{Time: transitionTime.Add(-time.Hour)},
+		},
+	}
+
+	condition.LastTransitionTime = metav1.Time{Time: transitionTime}
+	for i := range conditions {
+		if conditions[i].Type == condition.Type {
+			conditions[i] = condition
+		}
+	}
+
+	return v1alpha.Instance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%d", deployment.Name, index),
+			Labels: map[string]string{
+				v1alpha.InstanceIndexLabel: strconv.Itoa(index),
+			},
+		},
+		Status: v1alpha.InstanceStatus{
+			Conditions: conditions,
+		},
+	}
+}
@@ -15,10 +15,14 @@ type Strategy interface {
 	// the workload deployment to the desired state. Some actions may be informational,
 	// such as providing context into pending actions when instance management
 	// policies may require waiting for pod readiness.
+	//
+	// The current revision captures the instance template which instances are
+	// created from while the deployment is paused, and may be nil.
 	GetActions(
 		ctx context.Context,
 		scheme *runtime.Scheme,
 		deployment *v1alpha.WorkloadDeployment,
+		currentRevision *v1alpha.InstanceRevision,
 		currentInstances []v1alpha.Instance,
 	) ([]Action, error)
 }
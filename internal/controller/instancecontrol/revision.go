@@ -0,0 +1,174 @@
+package instancecontrol
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// DefaultRevisionHistoryLimit is the number of previous revisions retained for
+// a deployment when a limit has not been provided.
+const DefaultRevisionHistoryLimit = 10
+
+// NextRevision returns the revision number to be used for the next revision
+// created in a history of revisions.
+func NextRevision(revisions []v1alpha.InstanceRevision) int64 {
+	var maxRevision int64
+	for _, revision := range revisions {
+		maxRevision = max(maxRevision, revision.Revision)
+	}
+	return maxRevision + 1
+}
+
+// WorkloadTemplateHash returns a hash of the workload's instance template. When
+// placements override the template, the overrides are included so that
+// changes to them are also detected.
+func WorkloadTemplateHash(workload *v1alpha.Workload) string {
+	overrides := map[string][]v1alpha.WorkloadTemplateOverride{}
+	for _, placement := range workload.Spec.Placements {
+		if len(placement.TemplateOverrides) > 0 {
+			overrides[placement.Name] = placement.TemplateOverrides
+		}
+	}
+
+	if len(overrides) == 0 {
+		return ComputeHash(workload.Spec.Template)
+	}
+
+	return ComputeHash([]any{workload.Spec.Template, overrides})
+}
+
+// WorkloadTemplateRevision returns the revision number of the workload's
+// instance template, along with the hash the revision number is assigned to.
+// A new revision number is assigned when the instance template has changed
+// since the revision number recorded in the workload's status. New revision
+// numbers are greater than the revision numbers of all existing revisions
+// from the workload's deployments.
+func WorkloadTemplateRevision(workload *v1alpha.Workload, revisions []v1alpha.InstanceRevision) (int64, string) {
+	templateHash := WorkloadTemplateHash(workload)
+	if workload.Status.TemplateHash == templateHash {
+		return workload.Status.TemplateRevision, templateHash
+	}
+
+	return max(workload.Status.TemplateRevision+1, NextRevision(revisions)), templateHash
+}
+
+// DeploymentRevision returns the revision number to be used for the revision
+// which captures the deployment's instance template. The revision number
+// assigned by the workload is used when present. Otherwise, the current
+// revision retains its number when it is the latest revision, and the next
+// revision number in the deployment's history is used in all other cases.
+func DeploymentRevision(
+	deployment *v1alpha.WorkloadDeployment,
+	revisions []v1alpha.InstanceRevision,
+	currentRevision *v1alpha.InstanceRevision,
+) int64 {
+	if revision, err := strconv.ParseInt(deployment.Annotations[v1alpha.TemplateRevisionAnnotation], 10, 64); err == nil && revision > 0 {
+		return revision
+	}
+
+	nextRevision := NextRevision(revisions)
+	if currentRevision != nil && currentRevision.Revision == nextRevision-1 {
+		return currentRevision.Revision
+	}
+
+	return nextRevision
+}
+
+// FindRevision returns the revision in the history which captures the
+// instance template with the provided hash, or nil if one is not found.
+func FindRevision(revisions []v1alpha.InstanceRevision, templateHash string) *v1alpha.InstanceRevision {
+	for i, revision := range revisions {
+		if revision.Labels[v1alpha.InstanceTemplateHashLabel] == templateHash {
+			return &revisions[i]
+		}
+	}
+	return nil
+}
+
+// RevisionsToPrune returns the revisions which exceed the history limit. The
+// retained revisions, such as the current revision, are never pruned, and the
+// most recent revisions are retained.
+func RevisionsToPrune(
+	revisions []v1alpha.InstanceRevision,
+	revisionHistoryLimit int32,
+	retainedRevisionNames ...string,
+) []v1alpha.InstanceRevision {
+	var history []v1alpha.InstanceRevision
+	for _, revision := range revisions {
+		if !slices.Contains(retainedRevisionNames, revision.Name) {
+			history = append(history, revision)
+		}
+	}
+
+	if len(history) <= int(revisionHistoryLimit) {
+		return nil
+	}
+
+	slices.SortFunc(history, func(a, b v1alpha.InstanceRevision) int {
+		return cmp.Compare(a.Revision, b.Revision)
+	})
+
+	return history[:len(history)-int(revisionHistoryLimit)]
+}
+
+// FindRollbackRevision returns the revision to be used when rolling back to
+// the provided revision number. Revision 0 refers to the latest revision which
+// does not capture the current instance template.
+//
+// Revisions may originate from multiple deployments, in which case all
+// revisions with the same number must capture the same instance template.
+// Revisions which capture templates with placement overrides applied are not
+// considered, as they do not reflect the workload's instance template.
+func FindRollbackRevision(
+	revisions []v1alpha.InstanceRevision,
+	revisionNumber int64,
+	currentTemplateHash string,
+) (*v1alpha.InstanceRevision, error) {
+	var rollbackRevision *v1alpha.InstanceRevision
+	overriddenRevisionFound := false
+	for i, revision := range revisions {
+		if revision.Labels[v1alpha.TemplateOverriddenLabel] == "true" {
+			if revisionNumber == 0 || revision.Revision == revisionNumber {
+				overriddenRevisionFound = true
+			}
+			continue
+		}
+
+		templateHash := revision.Labels[v1alpha.InstanceTemplateHashLabel]
+		if revisionNumber == 0 {
+			if templateHash == currentTemplateHash {
+				continue
+			}
+
+			if rollbackRevision == nil || revision.Revision > rollbackRevision.Revision {
+				rollbackRevision = &revisions[i]
+			}
+			continue
+		}
+
+		if revision.Revision != revisionNumber {
+			continue
+		}
+
+		if rollbackRevision != nil && rollbackRevision.Labels[v1alpha.InstanceTemplateHashLabel] != templateHash {
+			return nil, fmt.Errorf("revision %d captures different instance templates across deployments", revisionNumber)
+		}
+		rollbackRevision = &revisions[i]
+	}
+
+	if rollbackRevision == nil {
+		if overriddenRevisionFound {
+			return nil, fmt.Errorf("matching revisions only capture instance templates with placement overrides applied")
+		}
+		if revisionNumber == 0 {
+			return nil, fmt.Errorf("no previous revision found")
+		}
+		return nil, fmt.Errorf("revision %d not found", revisionNumber)
+	}
+
+	return rollbackRevision, nil
+}
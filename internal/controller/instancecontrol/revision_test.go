@@ -0,0 +1,178 @@
+package instancecontrol
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestNextRevision(t *testing.T) {
+	assert.Equal(t, int64(1), NextRevision(nil))
+	assert.Equal(t, int64(4), NextRevision(getRevisions(3, 1, 2)))
+}
+
+func TestFindRevision(t *testing.T) {
+	revisions := getRevisions(1, 2, 3)
+
+	revision := FindRevision(revisions, "hash-2")
+	if assert.NotNil(t, revision) {
+		assert.Equal(t, int64(2), revision.Revision)
+	}
+
+	assert.Nil(t, FindRevision(revisions, "hash-4"))
+}
+
+func TestRevisionsToPrune(t *testing.T) {
+	revisions := getRevisions(4, 1, 5, 2, 3)
+
+	pruned := RevisionsToPrune(revisions, 2, "test-5")
+	assert.Len(t, pruned, 2)
+	assert.Equal(t, "test-1", pruned[0].Name)
+	assert.Equal(t, "test-2", pruned[1].Name)
+
+	assert.Empty(t, RevisionsToPrune(revisions, 10, "test-5"))
+}
+
+func TestRevisionsToPruneRetainsCurrentRevision(t *testing.T) {
+	revisions := getRevisions(1, 2, 3)
+
+	// The current revision may not be the newest revision while a rollback is
+	// being applied.
+	pruned := RevisionsToPrune(revisions, 0, "test-1")
+	assert.Len(t, pruned, 2)
+	for _, revision := range pruned {
+		assert.NotEqual(t, "test-1", revision.Name)
+	}
+}
+
+func TestWorkloadTemplateHashIncludesOverrides(t *testing.T) {
+	workload := &v1alpha.Workload{}
+	templateHash := WorkloadTemplateHash(workload)
+
+	workload.Spec.Placements = []v1alpha.WorkloadPlacement{
+		{
+			Name: "default",
+			TemplateOverrides: []v1alpha.WorkloadTemplateOverride{
+				{
+					CityCodes: []string{"dfw"},
+					Patch: runtime.RawExtension{
+						Raw: []byte(`{"spec":{"runtime":{"resources":{"instanceType":"datumcloud/d1-standard-4"}}}}`),
+					},
+				},
+			},
+		},
+	}
+
+	assert.NotEqual(t, templateHash, WorkloadTemplateHash(workload))
+}
+
+func TestWorkloadTemplateRevision(t *testing.T) {
+	workload := &v1alpha.Workload{}
+
+	revision, templateHash := WorkloadTemplateRevision(workload, nil)
+	assert.Equal(t, int64(1), revision)
+
+	workload.Status.TemplateRevision = revision
+	workload.Status.TemplateHash = templateHash
+	revision, _ = WorkloadTemplateRevision(workload, getRevisions(1))
+	assert.Equal(t, int64(1), revision)
+
+	// New revision numbers follow revisions created before the workload
+	// assigned revision numbers.
+	workload.Spec.Template.Spec.PowerState = v1alpha.StoppedInstancePowerState
+	revision, _ = WorkloadTemplateRevision(workload, getRevisions(1, 2, 3))
+	assert.Equal(t, int64(4), revision)
+}
+
+func TestDeploymentRevision(t *testing.T) {
+	deployment := &v1alpha.WorkloadDeployment{}
+	revisions := getRevisions(1, 2)
+
+	assert.Equal(t, int64(3), DeploymentRevision(deployment, revisions, nil))
+	assert.Equal(t, int64(2), DeploymentRevision(deployment, revisions, &revisions[1]))
+	assert.Equal(t, int64(3), DeploymentRevision(deployment, revisions, &revisions[0]))
+
+	// A deployment created after the workload's template changed several times
+	// uses the workload's revision number.
+	deployment.Annotations = map[string]string{
+		v1alpha.TemplateRevisionAnnotation: "5",
+	}
+	assert.Equal(t, int64(5), DeploymentRevision(deployment, nil, nil))
+}
+
+func getRevisions(revisionNumbers ...int64) []v1alpha.InstanceRevision {
+	var revisions []v1alpha.InstanceRevision
+	for _, revisionNumber := range revisionNumbers {
+		revisions = append(revisions, v1alpha.InstanceRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("test-%d", revisionNumber),
+				Labels: map[string]string{
+					v1alpha.InstanceTemplateHashLabel: fmt.Sprintf("hash-%d", revisionNumber),
+				},
+			},
+			Revision: revisionNumber,
+		})
+	}
+	return revisions
+}
+
+func TestFindRollbackRevision(t *testing.T) {
+	revisions := append(getRevisions(1, 2, 3), getRevisions(1, 2, 3)...)
+
+	revision, err := FindRollbackRevision(revisions, 2, "hash-3")
+	assert.NoError(t, err)
+	if assert.NotNil(t, revision) {
+		assert.Equal(t, int64(2), revision.Revision)
+	}
+
+	// Revision 0 refers to the revision prior to the current template.
+	revision, err = FindRollbackRevision(revisions, 0, "hash-3")
+	assert.NoError(t, err)
+	if assert.NotNil(t, revision) {
+		assert.Equal(t, int64(2), revision.Revision)
+	}
+
+	_, err = FindRollbackRevision(revisions, 4, "hash-3")
+	assert.ErrorContains(t, err, "revision 4 not found")
+
+	_, err = FindRollbackRevision(getRevisions(1), 0, "hash-1")
+	assert.ErrorContains(t, err, "no previous revision found")
+}
+
+func TestFindRollbackRevisionConflict(t *testing.T) {
+	revisions := getRevisions(1, 2)
+
+	// A revision number captured a different template in another deployment.
+	conflicting := getRevisions(2)
+	conflicting[0].Labels[v1alpha.InstanceTemplateHashLabel] = "hash-other"
+	revisions = append(revisions, conflicting...)
+
+	_, err := FindRollbackRevision(revisions, 2, "hash-1")
+	assert.ErrorContains(t, err, "different instance templates")
+}
+
+func TestFindRollbackRevisionIgnoresOverriddenTemplates(t *testing.T) {
+	revisions := getRevisions(1, 2)
+
+	// Revisions from deployments with placement overrides applied do not capture
+	// the workload's instance template.
+	overridden := getRevisions(1, 2)
+	for _, revision := range overridden {
+		revision.Labels[v1alpha.InstanceTemplateHashLabel] = "hash-overridden"
+		revision.Labels[v1alpha.TemplateOverriddenLabel] = "true"
+	}
+
+	revision, err := FindRollbackRevision(append(revisions, overridden...), 1, "hash-2")
+	assert.NoError(t, err)
+	if assert.NotNil(t, revision) {
+		assert.Equal(t, "hash-1", revision.Labels[v1alpha.InstanceTemplateHashLabel])
+	}
+
+	_, err = FindRollbackRevision(overridden, 1, "hash-2")
+	assert.ErrorContains(t, err, "placement overrides applied")
+}
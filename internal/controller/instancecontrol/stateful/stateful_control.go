@@ -28,9 +28,28 @@ func (c *statefulControl) GetActions(
 	ctx context.Context,
 	scheme *runtime.Scheme,
 	deployment *v1alpha.WorkloadDeployment,
+	currentRevision *v1alpha.InstanceRevision,
 	currentInstances []v1alpha.Instance,
 ) ([]instancecontrol.Action, error) {
 	instanceTemplateHash := instancecontrol.ComputeHash(deployment.Spec.Template)
+	policy := deployment.Spec.ScaleSettings.InstanceManagementPolicy
+
+	// While a deployment is paused, instances are created and deleted as the
+	// deployment scales, but existing instances are not updated. New instances
+	// are created from the template of the current revision, so that a new
+	// template is not rolled out to them.
+	paused := deployment.Spec.Paused
+	createTemplate := deployment.Spec.Template
+	createTemplateHash := instanceTemplateHash
+	if paused && currentRevision != nil {
+		createTemplate = currentRevision.Template
+		createTemplateHash = instancecontrol.ComputeHash(currentRevision.Template)
+	}
+
+	// Changes to the power state of instances are applied to all instances at
+	// once, and are not subject to the instance management policy.
+	powerState := instancecontrol.DesiredPowerState(deployment)
+	stopped := powerState == v1alpha.StoppedInstancePowerState
 
 	// lowest -> highest
 	var createActions []instancecontrol.Action
@@ -42,9 +61,23 @@ func (c *statefulControl) GetActions(
 	// highest -> lowest
 	var deleteActions []instancecontrol.Action
 
-	// Instances that are desired to exist. We do not currently support the
-	// concept of a partition, so will fill the entire slice.
-	desiredInstances := make([]*v1alpha.Instance, deployment.Spec.ScaleSettings.MinReplicas)
+	var powerStateActions []instancecontrol.Action
+
+	// lowest -> highest
+	var restartActions []instancecontrol.Action
+
+	replicas := int(instancecontrol.DesiredReplicas(deployment))
+	rollingUpdate := getRollingUpdateStrategy(deployment)
+
+	// Surge instances are only desired while a rolling update is in progress,
+	// and the deployment's instances are running.
+	var surge int
+	if !paused && !stopped && rollingUpdate != nil && rollingUpdateInProgress(rollingUpdate, replicas, currentInstances, instanceTemplateHash) {
+		surge = rollingUpdate.maxSurge
+	}
+
+	// Instances that are desired to exist.
+	desiredInstances := make([]*v1alpha.Instance, replicas+surge)
 
 	for _, instance := range currentInstances {
 		instanceIndex := getInstanceOrdinal(instance.Name)
@@ -57,28 +90,32 @@ func (c *statefulControl) GetActions(
 
 	// It's possible that the incoming currentInstances will have gaps in
 	// instances, so fill them in.
-	for i := range deployment.Spec.ScaleSettings.MinReplicas {
+	for i := range desiredInstances {
 		if desiredInstances[i] == nil {
 			desiredInstances[i] = &v1alpha.Instance{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels:      deployment.Spec.Template.Labels,
-					Annotations: deployment.Spec.Template.Annotations,
+					Labels:      createTemplate.Labels,
+					Annotations: createTemplate.Annotations,
 					Name:        fmt.Sprintf("%s-%d", deployment.Name, i),
 					Namespace:   deployment.Namespace,
 				},
-				Spec: deployment.Spec.Template.Spec,
+				Spec: createTemplate.Spec,
 			}
 			desiredInstances[i].Spec.Location = deployment.Status.Location
+			desiredInstances[i].Spec.PowerState = powerState
 
 			// TODO(jreese) consider adding scheduling gates via mutating webhooks
 			desiredInstances[i].Spec.Controller = &v1alpha.InstanceController{
-				TemplateHash: instanceTemplateHash,
+				TemplateHash: createTemplateHash,
 				SchedulingGates: []v1alpha.SchedulingGate{
 					{
 						Name: instancecontrol.NetworkSchedulingGate.String(),
 					},
 				},
 			}
+			if request := deployment.Spec.Restart; request != nil {
+				desiredInstances[i].Spec.Controller.RestartRequestedAt = request.RequestedAt.DeepCopy()
+			}
 
 			addInstanceControllerLabels(desiredInstances[i], getInstanceOrdinal(desiredInstances[i].Name), deployment)
 
@@ -88,6 +125,11 @@ func (c *statefulControl) GetActions(
 		}
 	}
 
+	// The number of desired instances which are currently available, used to
+	// limit the number of instances which may be updated at once when a rolling
+	// update strategy is in use.
+	availableInstances := 0
+
 	for _, instance := range desiredInstances {
 		if instance.CreationTimestamp.IsZero() {
 			action := instancecontrol.NewCreateAction(instance)
@@ -99,28 +141,78 @@ func (c *statefulControl) GetActions(
 			waitActions = append(waitActions, instancecontrol.NewWaitAction(instance))
 
 		} else if instance.DeletionTimestamp.IsZero() {
-			// Wait for the instance to be ready before continuing processing
-			if !apimeta.IsStatusConditionTrue(instance.Status.Conditions, v1alpha.InstanceReady) {
+			if instancecontrol.PowerState(instance.Spec) != powerState {
+				// Any template update will be applied once the power state has been
+				// changed.
+				powerStateActions = append(powerStateActions, getPowerStateAction(instance, powerState))
+				continue
+			}
+
+			// Instances which have been requested to restart are not considered to
+			// be ready until the restart has completed.
+			instanceReady := apimeta.IsStatusConditionTrue(instance.Status.Conditions, v1alpha.InstanceReady) &&
+				!instancecontrol.Restarting(instance)
+
+			// Wait for the instance to be ready before continuing processing,
+			// unless it has been intentionally stopped.
+			if !instanceReady && !stopped {
 				waitActions = append(waitActions, instancecontrol.NewWaitAction(instance))
-			} else if needsUpdate(instance, instanceTemplateHash) {
-				updatedInstance := instance.DeepCopy()
-				updatedInstance.Annotations = deployment.Spec.Template.Annotations
-				updatedInstance.Labels = deployment.Spec.Template.Labels
+			} else {
+				availableInstances++
+			}
 
-				addInstanceControllerLabels(updatedInstance, getInstanceOrdinal(updatedInstance.Name), deployment)
+			if paused || !needsUpdate(instance, instanceTemplateHash) {
+				// Restarts are requested once the instance's template is up to date.
+				if instancecontrol.RestartPending(deployment, instance) &&
+					(instanceReady || stopped || policy == v1alpha.ParallelInstanceManagementPolicyType) {
+					restartActions = append(restartActions, getRestartAction(instance, deployment))
+				}
+				continue
+			}
 
-				updatedInstance.Spec = deployment.Spec.Template.Spec
-				updateActions = append(updateActions, instancecontrol.NewUpdateAction(updatedInstance))
+			if rollingUpdate != nil {
+				// Instances below the partition retain their current template.
+				if getInstanceOrdinal(instance.Name) >= rollingUpdate.partition {
+					updateActions = append(updateActions, getUpdateAction(instance, deployment, instanceTemplateHash, powerState))
+				}
+			} else if instanceReady || stopped || policy == v1alpha.ParallelInstanceManagementPolicyType {
+				// Instances that are not ready are only updated when the policy does
+				// not require waiting for readiness, or they have been stopped.
+				updateActions = append(updateActions, getUpdateAction(instance, deployment, instanceTemplateHash, powerState))
 			}
 		}
 	}
 
 	slices.SortFunc(updateActions, descendingOrdinal)
 	slices.SortFunc(deleteActions, descendingOrdinal)
+	slices.SortFunc(restartActions, ascendingOrdinal)
+
+	// When a rolling update strategy is in use, updates are not subject to the
+	// instance management policy, and are instead limited by the number of
+	// instances that may be unavailable at once.
+	var rollingUpdateActions []instancecontrol.Action
+	if rollingUpdate != nil {
+		minAvailable := replicas - rollingUpdate.maxUnavailable
+		for i, action := range updateActions {
+			// Updating an instance that is not ready will not reduce availability.
+			if !apimeta.IsStatusConditionTrue(action.Object.(*v1alpha.Instance).Status.Conditions, v1alpha.InstanceReady) {
+				continue
+			}
 
-	actions := make([]instancecontrol.Action, 0, len(createActions)+len(waitActions)+len(updateActions)+len(deleteActions))
+			if availableInstances > minAvailable {
+				availableInstances--
+			} else {
+				updateActions[i].SkipExecution()
+			}
+		}
+
+		rollingUpdateActions = updateActions
+		updateActions = nil
+	}
+
+	actions := make([]instancecontrol.Action, 0, len(createActions)+len(waitActions)+len(updateActions)+len(deleteActions)+len(restartActions))
 
-	switch deployment.Spec.ScaleSettings.InstanceManagementPolicy {
+	switch policy {
 	case v1alpha.OrderedReadyInstanceManagementPolicyType:
 
 		// Add create and wait actions, and sort by ordinal. This allows us to wait
@@ -135,6 +227,7 @@ func (c *statefulControl) GetActions(
 
 		actions = append(actions, updateActions...)
 		actions = append(actions, deleteActions...)
+		actions = append(actions, restartActions...)
 
 		// Skip all actions except the first one.
 		for i := range actions {
@@ -143,11 +236,76 @@ func (c *statefulControl) GetActions(
 			}
 		}
 
+	case v1alpha.ParallelInstanceManagementPolicyType:
+
+		// All actions are executed at once. Wait actions are retained so that
+		// instances which are terminating or not yet ready are reflected, but they
+		// do not block other actions from being executed.
+		actions = append(actions, createActions...)
+		actions = append(actions, waitActions...)
+
+		slices.SortFunc(actions, ascendingOrdinal)
+
+		actions = append(actions, updateActions...)
+		actions = append(actions, deleteActions...)
+		actions = append(actions, restartActions...)
 	}
 
+	actions = append(actions, rollingUpdateActions...)
+	actions = append(actions, powerStateActions...)
+
 	return actions, nil
 }
 
+func getUpdateAction(
+	instance *v1alpha.Instance,
+	deployment *v1alpha.WorkloadDeployment,
+	instanceTemplateHash string,
+	powerState v1alpha.InstancePowerState,
+) instancecontrol.Action {
+	updatedInstance := instance.DeepCopy()
+	updatedInstance.Annotations = deployment.Spec.Template.Annotations
+	updatedInstance.Labels = deployment.Spec.Template.Labels
+
+	addInstanceControllerLabels(updatedInstance, getInstanceOrdinal(updatedInstance.Name), deployment)
+
+	// Retain fields driven by the controller, which are not present in the
+	// template.
+	updatedInstance.Spec = deployment.Spec.Template.Spec
+	updatedInstance.Spec.Location = instance.Spec.Location
+	updatedInstance.Spec.PowerState = powerState
+	updatedInstance.Spec.Controller = instance.Spec.Controller.DeepCopy()
+	if updatedInstance.Spec.Controller == nil {
+		updatedInstance.Spec.Controller = &v1alpha.InstanceController{}
+	}
+	updatedInstance.Spec.Controller.TemplateHash = instanceTemplateHash
+
+	return instancecontrol.NewUpdateAction(updatedInstance)
+}
+
+func getRestartAction(instance *v1alpha.Instance, deployment *v1alpha.WorkloadDeployment) instancecontrol.Action {
+	request := deployment.Spec.Restart
+	if request.Type == v1alpha.RecreateInstanceRestartType {
+		// The instance will be created again once it has been deleted.
+		return instancecontrol.NewDeleteAction(instance)
+	}
+
+	updatedInstance := instance.DeepCopy()
+	if updatedInstance.Spec.Controller == nil {
+		updatedInstance.Spec.Controller = &v1alpha.InstanceController{}
+	}
+	updatedInstance.Spec.Controller.RestartedAt = request.RequestedAt.DeepCopy()
+	updatedInstance.Spec.Controller.RestartRequestedAt = request.RequestedAt.DeepCopy()
+
+	return instancecontrol.NewUpdateAction(updatedInstance)
+}
+
+func getPowerStateAction(instance *v1alpha.Instance, powerState v1alpha.InstancePowerState) instancecontrol.Action {
+	updatedInstance := instance.DeepCopy()
+	updatedInstance.Spec.PowerState = powerState
+	return instancecontrol.NewUpdateAction(updatedInstance)
+}
+
 func addInstanceControllerLabels(instance *v1alpha.Instance, index int, deployment *v1alpha.WorkloadDeployment) {
 	if instance.Labels == nil {
 		instance.Labels = map[string]string{}
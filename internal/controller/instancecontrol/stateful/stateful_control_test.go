@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 
@@ -33,7 +35,7 @@ func TestFreshDeployment(t *testing.T) {
 
 	// No instances
 	var currentInstances []v1alpha.Instance
-	actions, err := control.GetActions(ctx, scheme, deployment, currentInstances)
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
 
 	assert.NoError(t, err)
 	assert.Len(t, actions, 2)
@@ -59,7 +61,7 @@ func TestUpdateWithAllReadyInstances(t *testing.T) {
 
 	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
 
-	actions, err := control.GetActions(ctx, scheme, deployment, currentInstances)
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
 
 	assert.NoError(t, err)
 	assert.Len(t, actions, 2)
@@ -89,7 +91,7 @@ func TestScaleUpWithNotReadyInstance(t *testing.T) {
 	})
 	currentInstances = append(currentInstances, *notReadyInstance)
 
-	actions, err := control.GetActions(ctx, scheme, deployment, currentInstances)
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
 
 	assert.NoError(t, err)
 	assert.Len(t, actions, 2)
@@ -116,7 +118,7 @@ func TestScaleUpWithDeletingReadyInstance(t *testing.T) {
 	deletingInstance.DeletionTimestamp = ptr.To(metav1.Now())
 	currentInstances = append(currentInstances, *deletingInstance)
 
-	actions, err := control.GetActions(ctx, scheme, deployment, currentInstances)
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
 
 	assert.NoError(t, err)
 	assert.Len(t, actions, 2)
@@ -140,7 +142,7 @@ func TestScaleDownWithAllReadyInstances(t *testing.T) {
 	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
 	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
 
-	actions, err := control.GetActions(ctx, scheme, deployment, currentInstances)
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
 
 	assert.NoError(t, err)
 	assert.Len(t, actions, 1)
@@ -150,6 +152,629 @@ func TestScaleDownWithAllReadyInstances(t *testing.T) {
 	assert.False(t, actions[0].IsSkipped())
 }
 
+func TestParallelFreshDeployment(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-fresh-deploy", 3)
+	deployment.Spec.ScaleSettings.InstanceManagementPolicy = v1alpha.ParallelInstanceManagementPolicyType
+
+	// No instances
+	var currentInstances []v1alpha.Instance
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 3)
+
+	for i, action := range actions {
+		assert.Equal(t, fmt.Sprintf("test-fresh-deploy-%d", i), action.Object.GetName())
+		assert.Equal(t, instancecontrol.ActionTypeCreate, action.ActionType())
+		assert.False(t, action.IsSkipped())
+	}
+}
+
+func TestParallelUpdateWithNotReadyInstance(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+	deployment.Spec.ScaleSettings.InstanceManagementPolicy = v1alpha.ParallelInstanceManagementPolicyType
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+
+	notReadyInstance := getInstanceForDeployment(deployment, 1)
+	apimeta.SetStatusCondition(&notReadyInstance.Status.Conditions, metav1.Condition{
+		Type:   v1alpha.InstanceReady,
+		Status: metav1.ConditionFalse,
+	})
+	currentInstances = append(currentInstances, *notReadyInstance)
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 3)
+
+	assert.Equal(t, "test-deploy-1", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeWait, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+
+	assert.Equal(t, "test-deploy-1", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[1].ActionType())
+	assert.False(t, actions[1].IsSkipped())
+
+	assert.Equal(t, "test-deploy-0", actions[2].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[2].ActionType())
+	assert.False(t, actions[2].IsSkipped())
+}
+
+func TestParallelScaleWithDeletingInstance(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 3)
+	deployment.Spec.ScaleSettings.InstanceManagementPolicy = v1alpha.ParallelInstanceManagementPolicyType
+
+	var currentInstances []v1alpha.Instance
+	deletingInstance := getInstanceForDeployment(deployment, 0)
+	deletingInstance.DeletionTimestamp = ptr.To(metav1.Now())
+	currentInstances = append(currentInstances, *deletingInstance)
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 3))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 4))
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 5)
+
+	assert.Equal(t, "test-deploy-0", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeWait, actions[0].ActionType())
+
+	assert.Equal(t, "test-deploy-1", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[1].ActionType())
+
+	assert.Equal(t, "test-deploy-2", actions[2].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[2].ActionType())
+
+	assert.Equal(t, "test-deploy-4", actions[3].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeDelete, actions[3].ActionType())
+
+	assert.Equal(t, "test-deploy-3", actions[4].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeDelete, actions[4].ActionType())
+
+	for _, action := range actions {
+		assert.False(t, action.IsSkipped())
+	}
+}
+
+func TestRollingUpdateMaxUnavailable(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 4)
+	deployment.Spec.ScaleSettings.UpdateStrategy = &v1alpha.InstanceUpdateStrategy{
+		Type: v1alpha.RollingUpdateInstanceUpdateStrategyType,
+		RollingUpdate: &v1alpha.RollingUpdateInstanceStrategy{
+			MaxUnavailable: ptr.To(intstr.FromString("50%")),
+		},
+	}
+
+	var currentInstances []v1alpha.Instance
+	for i := range 4 {
+		currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, i))
+	}
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 4)
+
+	for i, action := range actions {
+		assert.Equal(t, fmt.Sprintf("test-deploy-%d", 3-i), action.Object.GetName())
+		assert.Equal(t, instancecontrol.ActionTypeUpdate, action.ActionType())
+		assert.Equal(t, i >= 2, action.IsSkipped())
+	}
+
+	updatedInstance := actions[0].Object.(*v1alpha.Instance)
+	assert.Equal(t, instancecontrol.ComputeHash(deployment.Spec.Template), updatedInstance.Spec.Controller.TemplateHash)
+}
+
+func TestRollingUpdateNotReadyInstancesCountTowardsUnavailable(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 3)
+	deployment.Spec.ScaleSettings.UpdateStrategy = &v1alpha.InstanceUpdateStrategy{
+		Type: v1alpha.RollingUpdateInstanceUpdateStrategyType,
+	}
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	notReadyInstance := getInstanceForDeployment(deployment, 2)
+	apimeta.SetStatusCondition(&notReadyInstance.Status.Conditions, metav1.Condition{
+		Type:   v1alpha.InstanceReady,
+		Status: metav1.ConditionFalse,
+	})
+	currentInstances = append(currentInstances, *notReadyInstance)
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 4)
+
+	assert.Equal(t, "test-deploy-2", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeWait, actions[0].ActionType())
+
+	// The instance which is not ready can be updated without reducing
+	// availability, but no other instances may be updated.
+	assert.Equal(t, "test-deploy-2", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[1].ActionType())
+	assert.False(t, actions[1].IsSkipped())
+
+	assert.Equal(t, "test-deploy-1", actions[2].Object.GetName())
+	assert.True(t, actions[2].IsSkipped())
+
+	assert.Equal(t, "test-deploy-0", actions[3].Object.GetName())
+	assert.True(t, actions[3].IsSkipped())
+}
+
+func TestRollingUpdatePartition(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 4)
+	deployment.Spec.ScaleSettings.UpdateStrategy = &v1alpha.InstanceUpdateStrategy{
+		Type: v1alpha.RollingUpdateInstanceUpdateStrategyType,
+		RollingUpdate: &v1alpha.RollingUpdateInstanceStrategy{
+			MaxUnavailable: ptr.To(intstr.FromInt32(4)),
+			Partition:      ptr.To(int32(2)),
+		},
+	}
+
+	var currentInstances []v1alpha.Instance
+	for i := range 4 {
+		currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, i))
+	}
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "test-deploy-3", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+
+	assert.Equal(t, "test-deploy-2", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[1].ActionType())
+	assert.False(t, actions[1].IsSkipped())
+}
+
+func TestRollingUpdateMaxSurge(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+	deployment.Spec.ScaleSettings.UpdateStrategy = &v1alpha.InstanceUpdateStrategy{
+		Type: v1alpha.RollingUpdateInstanceUpdateStrategyType,
+		RollingUpdate: &v1alpha.RollingUpdateInstanceStrategy{
+			MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			MaxSurge:       ptr.To(intstr.FromInt32(1)),
+		},
+	}
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 3)
+
+	assert.Equal(t, "test-deploy-2", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+
+	// No instances may be updated until the surge instance is ready.
+	assert.Equal(t, "test-deploy-1", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[1].ActionType())
+	assert.True(t, actions[1].IsSkipped())
+
+	assert.Equal(t, "test-deploy-0", actions[2].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[2].ActionType())
+	assert.True(t, actions[2].IsSkipped())
+
+	// Once the surge instance is ready, a single instance may be updated.
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 2))
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "test-deploy-1", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+
+	assert.Equal(t, "test-deploy-0", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[1].ActionType())
+	assert.True(t, actions[1].IsSkipped())
+
+	// The surge instance is removed once all instances are updated and ready.
+	currentInstances = []v1alpha.Instance{
+		*getInstanceForDeployment(deployment, 0),
+		*getInstanceForDeployment(deployment, 1),
+		*getInstanceForDeployment(deployment, 2),
+	}
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 1)
+
+	assert.Equal(t, "test-deploy-2", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeDelete, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+}
+
+func TestScaleUpWithAutoscaledReplicas(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 1)
+	deployment.Spec.ScaleSettings.MaxReplicas = ptr.To(int32(3))
+	deployment.Status.Autoscaling = &v1alpha.WorkloadDeploymentAutoscalingStatus{
+		// Bounded by the maximum replicas.
+		DesiredReplicas: 5,
+	}
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "test-deploy-1", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+
+	assert.Equal(t, "test-deploy-2", actions[1].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[1].ActionType())
+	assert.True(t, actions[1].IsSkipped())
+}
+
+func TestPausedDeploymentSkipsUpdates(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 3)
+	deployment.Spec.Paused = true
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	// Existing instances are not updated, but the deployment continues to scale.
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 1)
+
+	assert.Equal(t, "test-deploy-2", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+
+	// Updates resume once the deployment is no longer paused.
+	deployment.Spec.Paused = false
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 2))
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "test-deploy-1", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+}
+
+func TestPausedDeploymentCreatesFromCurrentRevision(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 3)
+	deployment.Spec.Paused = true
+
+	currentRevision := &v1alpha.InstanceRevision{
+		Template: *deployment.Spec.Template.DeepCopy(),
+	}
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	// Instances created while scaling up use the template of the current
+	// revision.
+	actions, err := control.GetActions(ctx, scheme, deployment, currentRevision, currentInstances)
+
+	assert.NoError(t, err)
+	if assert.Len(t, actions, 1) {
+		assert.Equal(t, instancecontrol.ActionTypeCreate, actions[0].ActionType())
+
+		instance := actions[0].Object.(*v1alpha.Instance)
+		assert.Equal(t, "test", instance.Spec.Runtime.Sandbox.Containers[0].Image)
+		assert.Equal(t, instancecontrol.ComputeHash(currentRevision.Template), instance.Spec.Controller.TemplateHash)
+	}
+
+	// Once resumed, instances are created from the deployment's template.
+	deployment.Spec.Paused = false
+	actions, err = control.GetActions(ctx, scheme, deployment, currentRevision, currentInstances[:1])
+
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, actions) {
+		instance := actions[0].Object.(*v1alpha.Instance)
+		assert.Equal(t, "test-deploy-1", instance.Name)
+		assert.Equal(t, "test-image-update", instance.Spec.Runtime.Sandbox.Containers[0].Image)
+	}
+}
+
+func TestPausedRollingUpdateDoesNotSurge(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+	deployment.Spec.Paused = true
+	deployment.Spec.ScaleSettings.UpdateStrategy = &v1alpha.InstanceUpdateStrategy{
+		Type: v1alpha.RollingUpdateInstanceUpdateStrategyType,
+		RollingUpdate: &v1alpha.RollingUpdateInstanceStrategy{
+			MaxSurge: ptr.To(intstr.FromInt32(1)),
+		},
+	}
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Empty(t, actions)
+}
+
+func TestSuspendedDeploymentStopsAllInstances(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 3)
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	deployment.Spec.Suspend = true
+
+	// Power state changes are not subject to the instance management policy.
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 3)
+
+	assert.Equal(t, "test-deploy-2", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeCreate, actions[0].ActionType())
+	assert.Equal(t, v1alpha.StoppedInstancePowerState, actions[0].Object.(*v1alpha.Instance).Spec.PowerState)
+	assert.False(t, actions[0].IsSkipped())
+
+	for _, action := range actions[1:] {
+		assert.Equal(t, instancecontrol.ActionTypeUpdate, action.ActionType())
+		assert.Equal(t, v1alpha.StoppedInstancePowerState, action.Object.(*v1alpha.Instance).Spec.PowerState)
+		assert.False(t, action.IsSkipped())
+	}
+
+	// Stopped instances which are not ready do not block template updates.
+	for i := range currentInstances {
+		currentInstances[i].Spec.PowerState = v1alpha.StoppedInstancePowerState
+		apimeta.SetStatusCondition(&currentInstances[i].Status.Conditions, metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionFalse,
+			Reason: v1alpha.InstanceRunningReasonStopped,
+		})
+	}
+	stoppedInstance := getInstanceForDeployment(deployment, 2)
+	stoppedInstance.Spec.PowerState = v1alpha.StoppedInstancePowerState
+	currentInstances = append(currentInstances, *stoppedInstance)
+
+	deployment.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Image = "test-image-update"
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 3)
+
+	assert.Equal(t, "test-deploy-2", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[0].ActionType())
+	assert.Equal(t, v1alpha.StoppedInstancePowerState, actions[0].Object.(*v1alpha.Instance).Spec.PowerState)
+	assert.False(t, actions[0].IsSkipped())
+}
+
+func TestResumedDeploymentStartsInstances(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+
+	var currentInstances []v1alpha.Instance
+	for i := range 2 {
+		instance := getInstanceForDeployment(deployment, i)
+		instance.Spec.PowerState = v1alpha.StoppedInstancePowerState
+		currentInstances = append(currentInstances, *instance)
+	}
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	for _, action := range actions {
+		assert.Equal(t, instancecontrol.ActionTypeUpdate, action.ActionType())
+		assert.Equal(t, v1alpha.RunningInstancePowerState, action.Object.(*v1alpha.Instance).Spec.PowerState)
+		assert.False(t, action.IsSkipped())
+	}
+}
+
+func TestRestartInOrdinalOrder(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	requestedAt := metav1.NewTime(currentInstances[0].CreationTimestamp.Add(time.Minute))
+	deployment.Spec.Restart = &v1alpha.InstanceRestartRequest{
+		RequestedAt: requestedAt,
+		Type:        v1alpha.RestartInstanceRestartType,
+	}
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "test-deploy-0", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[0].ActionType())
+	assert.Equal(t, &requestedAt, actions[0].Object.(*v1alpha.Instance).Spec.Controller.RestartedAt)
+	assert.False(t, actions[0].IsSkipped())
+
+	assert.Equal(t, "test-deploy-1", actions[1].Object.GetName())
+	assert.True(t, actions[1].IsSkipped())
+
+	// The next instance is not restarted until the restart has completed.
+	currentInstances[0] = *actions[0].Object.(*v1alpha.Instance)
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "test-deploy-0", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeWait, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+	assert.True(t, actions[1].IsSkipped())
+
+	currentInstances[0].Status.Controller = &v1alpha.InstanceControllerStatus{
+		ObservedRestartedAt: &requestedAt,
+	}
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 1)
+
+	assert.Equal(t, "test-deploy-1", actions[0].Object.GetName())
+	assert.Equal(t, instancecontrol.ActionTypeUpdate, actions[0].ActionType())
+	assert.False(t, actions[0].IsSkipped())
+}
+
+func TestRecreateDeletesInstances(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+	deployment.Spec.ScaleSettings.InstanceManagementPolicy = v1alpha.ParallelInstanceManagementPolicyType
+
+	var currentInstances []v1alpha.Instance
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 0))
+	currentInstances = append(currentInstances, *getInstanceForDeployment(deployment, 1))
+
+	deployment.Spec.Restart = &v1alpha.InstanceRestartRequest{
+		RequestedAt: metav1.NewTime(currentInstances[0].CreationTimestamp.Add(time.Minute)),
+		Type:        v1alpha.RecreateInstanceRestartType,
+	}
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	for _, action := range actions {
+		assert.Equal(t, instancecontrol.ActionTypeDelete, action.ActionType())
+		assert.False(t, action.IsSkipped())
+	}
+
+	// Instances created after the request are not recreated.
+	deployment.Spec.Restart.RequestedAt = metav1.NewTime(currentInstances[0].CreationTimestamp.Add(-time.Minute))
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Empty(t, actions)
+}
+
+func TestRecreatedInstancesRecordRestartRequest(t *testing.T) {
+	ctx := context.Background()
+	control := New()
+
+	deployment := getWorkloadDeployment("test-deploy", 2)
+	deployment.Spec.ScaleSettings.InstanceManagementPolicy = v1alpha.ParallelInstanceManagementPolicyType
+
+	// A request in the future must not cause instances to be recreated again
+	// after they have been created while the request is in effect.
+	requestedAt := metav1.NewTime(time.Now().Add(time.Hour))
+	deployment.Spec.Restart = &v1alpha.InstanceRestartRequest{
+		RequestedAt: requestedAt,
+		Type:        v1alpha.RecreateInstanceRestartType,
+	}
+
+	actions, err := control.GetActions(ctx, scheme, deployment, nil, nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	var currentInstances []v1alpha.Instance
+	for _, action := range actions {
+		assert.Equal(t, instancecontrol.ActionTypeCreate, action.ActionType())
+
+		instance := *action.Object.(*v1alpha.Instance)
+		assert.Equal(t, &requestedAt, instance.Spec.Controller.RestartRequestedAt)
+		assert.Nil(t, instance.Spec.Controller.RestartedAt)
+
+		instance.CreationTimestamp = metav1.Now()
+		apimeta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:   v1alpha.InstanceReady,
+			Status: metav1.ConditionTrue,
+		})
+		currentInstances = append(currentInstances, instance)
+	}
+
+	actions, err = control.GetActions(ctx, scheme, deployment, nil, currentInstances)
+
+	assert.NoError(t, err)
+	assert.Empty(t, actions)
+}
+
 // Add more test functions below for different scenarios.
 
 func getWorkloadDeployment(name string, minReplicas int32) *v1alpha.WorkloadDeployment {
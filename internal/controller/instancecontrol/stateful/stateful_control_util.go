@@ -4,6 +4,9 @@ import (
 	"strconv"
 	"strings"
 
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
 	"go.datum.net/workload-operator/api/v1alpha"
 	"go.datum.net/workload-operator/internal/controller/instancecontrol"
 )
@@ -44,3 +47,79 @@ func descendingOrdinal(a, b instancecontrol.Action) int {
 		return 1
 	}
 }
+
+// rollingUpdateStrategy contains the resolved values of a rolling update
+// strategy for a deployment.
+type rollingUpdateStrategy struct {
+	maxUnavailable int
+	maxSurge       int
+	partition      int
+}
+
+// getRollingUpdateStrategy returns the resolved rolling update strategy for the
+// deployment, or nil if the deployment does not define one.
+func getRollingUpdateStrategy(deployment *v1alpha.WorkloadDeployment) *rollingUpdateStrategy {
+	updateStrategy := deployment.Spec.ScaleSettings.UpdateStrategy
+	if updateStrategy == nil || updateStrategy.Type != v1alpha.RollingUpdateInstanceUpdateStrategyType {
+		return nil
+	}
+
+	replicas := int(instancecontrol.DesiredReplicas(deployment))
+	strategy := &rollingUpdateStrategy{
+		maxUnavailable: 1,
+	}
+
+	if rollingUpdate := updateStrategy.RollingUpdate; rollingUpdate != nil {
+		if rollingUpdate.MaxUnavailable != nil {
+			// Validation ensures values are well formed, so errors are ignored.
+			maxUnavailable, _ := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxUnavailable, replicas, false)
+			strategy.maxUnavailable = maxUnavailable
+		}
+
+		if rollingUpdate.MaxSurge != nil {
+			maxSurge, _ := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, replicas, true)
+			strategy.maxSurge = maxSurge
+		}
+
+		if rollingUpdate.Partition != nil {
+			strategy.partition = int(*rollingUpdate.Partition)
+		}
+	}
+
+	// Progress must always be possible.
+	if strategy.maxUnavailable == 0 && strategy.maxSurge == 0 {
+		strategy.maxUnavailable = 1
+	}
+
+	return strategy
+}
+
+// rollingUpdateInProgress returns true if any instance covered by the rolling
+// update strategy needs to be updated. If surge instances exist, the update is
+// considered to be in progress until all desired instances are also ready.
+func rollingUpdateInProgress(
+	strategy *rollingUpdateStrategy,
+	replicas int,
+	instances []v1alpha.Instance,
+	instanceTemplateHash string,
+) bool {
+	surgeInstancesExist := false
+	allReady := true
+	for _, instance := range instances {
+		ordinal := getInstanceOrdinal(instance.Name)
+		if ordinal >= replicas {
+			surgeInstancesExist = true
+			continue
+		}
+
+		if !apimeta.IsStatusConditionTrue(instance.Status.Conditions, v1alpha.InstanceReady) {
+			allReady = false
+		}
+
+		if ordinal >= strategy.partition && instance.DeletionTimestamp.IsZero() && needsUpdate(&instance, instanceTemplateHash) {
+			return true
+		}
+	}
+
+	return surgeInstancesExist && !allReady
+}
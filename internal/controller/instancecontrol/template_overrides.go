@@ -0,0 +1,57 @@
+package instancecontrol
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// ApplyTemplateOverrides returns the instance template resulting from applying
+// the overrides which apply to the city, in order. Overrides which do not list
+// any city codes apply to all cities. The second return value is true if any
+// overrides were applied.
+func ApplyTemplateOverrides(
+	template v1alpha.InstanceTemplateSpec,
+	overrides []v1alpha.WorkloadTemplateOverride,
+	cityCode string,
+) (v1alpha.InstanceTemplateSpec, bool, error) {
+	var patches [][]byte
+	for _, override := range overrides {
+		if len(override.CityCodes) == 0 || slices.Contains(override.CityCodes, cityCode) {
+			patches = append(patches, override.Patch.Raw)
+		}
+	}
+
+	if len(patches) == 0 {
+		return template, false, nil
+	}
+
+	templateJSON, err := json.Marshal(template)
+	if err != nil {
+		return v1alpha.InstanceTemplateSpec{}, false, fmt.Errorf("failed marshaling instance template: %w", err)
+	}
+
+	for _, patch := range patches {
+		templateJSON, err = strategicpatch.StrategicMergePatch(templateJSON, patch, v1alpha.InstanceTemplateSpec{})
+		if err != nil {
+			return v1alpha.InstanceTemplateSpec{}, false, fmt.Errorf("failed applying template override: %w", err)
+		}
+	}
+
+	// Reject fields which are not part of the instance template, as they would
+	// otherwise be silently dropped.
+	decoder := json.NewDecoder(bytes.NewReader(templateJSON))
+	decoder.DisallowUnknownFields()
+
+	var result v1alpha.InstanceTemplateSpec
+	if err := decoder.Decode(&result); err != nil {
+		return v1alpha.InstanceTemplateSpec{}, false, fmt.Errorf("failed decoding overridden instance template: %w", err)
+	}
+
+	return result, true, nil
+}
@@ -0,0 +1,73 @@
+package instancecontrol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestApplyTemplateOverrides(t *testing.T) {
+	template := v1alpha.InstanceTemplateSpec{
+		Spec: v1alpha.InstanceSpec{
+			Runtime: v1alpha.InstanceRuntimeSpec{
+				Sandbox: &v1alpha.SandboxRuntime{
+					Containers: []v1alpha.SandboxContainer{
+						{
+							Name:  "app",
+							Image: "registry.tld/app:v1",
+							Env: []v1alpha.EnvVar{
+								{Name: "A", Value: "a"},
+							},
+						},
+						{
+							Name:  "sidecar",
+							Image: "registry.tld/sidecar:v1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	overrides := []v1alpha.WorkloadTemplateOverride{
+		{
+			Patch: runtime.RawExtension{
+				Raw: []byte(`{"spec":{"runtime":{"sandbox":{"containers":[{"name":"app","env":[{"name":"B","value":"b"}]}]}}}}`),
+			},
+		},
+		{
+			CityCodes: []string{"DFW"},
+			Patch: runtime.RawExtension{
+				Raw: []byte(`{"spec":{"runtime":{"sandbox":{"containers":[{"name":"sidecar","image":"registry.tld/sidecar:dfw"}]}}}}`),
+			},
+		},
+	}
+
+	result, overridden, err := ApplyTemplateOverrides(template, overrides, "LHR")
+	assert.NoError(t, err)
+	assert.True(t, overridden)
+	containers := result.Spec.Runtime.Sandbox.Containers
+	if assert.Len(t, containers, 2) {
+		assert.Len(t, containers[0].Env, 2)
+		assert.Equal(t, "registry.tld/sidecar:v1", containers[1].Image)
+	}
+
+	result, _, err = ApplyTemplateOverrides(template, overrides, "DFW")
+	assert.NoError(t, err)
+	assert.Equal(t, "registry.tld/sidecar:dfw", result.Spec.Runtime.Sandbox.Containers[1].Image)
+
+	result, overridden, err = ApplyTemplateOverrides(template, overrides[1:], "LHR")
+	assert.NoError(t, err)
+	assert.False(t, overridden)
+	assert.Equal(t, template, result)
+
+	_, _, err = ApplyTemplateOverrides(template, []v1alpha.WorkloadTemplateOverride{
+		{
+			Patch: runtime.RawExtension{Raw: []byte(`{"spec":{"unknown":true}}`)},
+		},
+	}, "DFW")
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/controller/scheduling"
+)
+
+// getPlacementCityCodes returns the city codes which have at least one
+// location matching the placement. When the placement lists city codes, their
+// order is retained. Otherwise, city codes found in the topology of locations
+// matching the placement's location selector are returned in sorted order.
+//
+// Locations without a city code in their topology are not considered.
+func getPlacementCityCodes(
+	placement computev1alpha.WorkloadPlacement,
+	locations []networkingv1alpha.Location,
+) ([]string, error) {
+	selector, err := scheduling.LocationSelectorAsSelector(placement.LocationSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	matchingCityCodes := sets.Set[string]{}
+	for _, location := range locations {
+		cityCode, ok := location.Spec.Topology[computev1alpha.CityCodeTopologyKey]
+		if ok && scheduling.LocationMatches(location, cityCode, selector) {
+			matchingCityCodes.Insert(cityCode)
+		}
+	}
+
+	if len(placement.CityCodes) == 0 {
+		return sets.List(matchingCityCodes), nil
+	}
+
+	var cityCodes []string
+	for _, cityCode := range placement.CityCodes {
+		if matchingCityCodes.Has(cityCode) && !slices.Contains(cityCodes, cityCode) {
+			cityCodes = append(cityCodes, cityCode)
+		}
+	}
+
+	return cityCodes, nil
+}
+
+// getPlacementCityCodesCondition returns a condition describing whether the
+// placement's city codes were resolved to locations.
+func getPlacementCityCodesCondition(placement computev1alpha.WorkloadPlacement, cityCodes []string) metav1.Condition {
+	condition := metav1.Condition{
+		Type:    computev1alpha.WorkloadPlacementCityCodesResolved,
+		Status:  metav1.ConditionTrue,
+		Reason:  "CityCodesResolved",
+		Message: "Locations were found for all city codes in the placement",
+	}
+
+	if len(placement.CityCodes) == 0 {
+		if len(cityCodes) == 0 {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "NoMatchingLocations"
+			condition.Message = "No locations match the placement's location selector"
+		}
+		return condition
+	}
+
+	var unresolvedCityCodes []string
+	for _, cityCode := range placement.CityCodes {
+		if !slices.Contains(cityCodes, cityCode) && !slices.Contains(unresolvedCityCodes, cityCode) {
+			unresolvedCityCodes = append(unresolvedCityCodes, cityCode)
+		}
+	}
+
+	if len(unresolvedCityCodes) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "UnresolvedCityCodes"
+		condition.Message = fmt.Sprintf("No locations were found for city codes: %s", strings.Join(unresolvedCityCodes, ", "))
+	}
+
+	return condition
+}
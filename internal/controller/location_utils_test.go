@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestGetPlacementCityCodes(t *testing.T) {
+	locations := []networkingv1alpha.Location{
+		getTestLocation("dfw", "us-central"),
+		getTestLocation("lhr", "eu-west"),
+		getTestLocation("fra", "eu-central"),
+		getTestLocation("ams", "eu-west"),
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "no-city-code",
+			},
+			Spec: networkingv1alpha.LocationSpec{
+				Topology: map[string]string{
+					"topology.datum.net/region": "eu-west",
+				},
+			},
+		},
+	}
+
+	euSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      "topology.datum.net/region",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"eu-west", "eu-central"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		placement         computev1alpha.WorkloadPlacement
+		expectedCityCodes []string
+	}{
+		{
+			name: "city codes retain order",
+			placement: computev1alpha.WorkloadPlacement{
+				CityCodes: []string{"lhr", "dfw", "sea"},
+			},
+			expectedCityCodes: []string{"lhr", "dfw"},
+		},
+		{
+			name: "location selector",
+			placement: computev1alpha.WorkloadPlacement{
+				LocationSelector: euSelector,
+			},
+			expectedCityCodes: []string{"ams", "fra", "lhr"},
+		},
+		{
+			name: "city codes and location selector",
+			placement: computev1alpha.WorkloadPlacement{
+				CityCodes:        []string{"dfw", "fra"},
+				LocationSelector: euSelector,
+			},
+			expectedCityCodes: []string{"fra"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cityCodes, err := getPlacementCityCodes(tt.placement, locations)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCityCodes, cityCodes)
+		})
+	}
+}
+
+func TestGetPlacementCityCodesCondition(t *testing.T) {
+	tests := []struct {
+		name            string
+		placement       computev1alpha.WorkloadPlacement
+		cityCodes       []string
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "all city codes resolved",
+			placement: computev1alpha.WorkloadPlacement{
+				CityCodes: []string{"dfw", "lhr"},
+			},
+			cityCodes:      []string{"dfw", "lhr"},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: "CityCodesResolved",
+		},
+		{
+			name: "unresolved city codes",
+			placement: computev1alpha.WorkloadPlacement{
+				CityCodes: []string{"dfw", "sea", "lhr", "ord"},
+			},
+			cityCodes:       []string{"dfw", "lhr"},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  "UnresolvedCityCodes",
+			expectedMessage: "No locations were found for city codes: sea, ord",
+		},
+		{
+			name: "location selector matches no locations",
+			placement: computev1alpha.WorkloadPlacement{
+				LocationSelector: &metav1.LabelSelector{},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "NoMatchingLocations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := getPlacementCityCodesCondition(tt.placement, tt.cityCodes)
+			assert.Equal(t, computev1alpha.WorkloadPlacementCityCodesResolved, condition.Type)
+			assert.Equal(t, tt.expectedStatus, condition.Status)
+			assert.Equal(t, tt.expectedReason, condition.Reason)
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, condition.Message)
+			}
+		})
+	}
+}
+
+func getTestLocation(cityCode, region string) networkingv1alpha.Location {
+	return networkingv1alpha.Location{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cityCode,
+		},
+		Spec: networkingv1alpha.LocationSpec{
+			Topology: map[string]string{
+				computev1alpha.CityCodeTopologyKey: cityCode,
+				"topology.datum.net/region":        region,
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package controller
+
+import (
+	"sort"
+
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+)
+
+// getCityScaleSettings returns the scale settings for deployments in the city,
+// preferring settings provided for the city over the placement's settings.
+func getCityScaleSettings(placement computev1alpha.WorkloadPlacement, cityCode string) computev1alpha.HorizontalScaleSettings {
+	for _, cityScaleSettings := range placement.CityScaleSettings {
+		if cityScaleSettings.CityCode == cityCode {
+			return cityScaleSettings.ScaleSettings
+		}
+	}
+	return placement.ScaleSettings
+}
+
+// getCityReplicas returns the number of replicas desired in each of the
+// placement's cities. When the placement does not distribute replicas, each
+// city receives the minimum number of replicas from its scale settings.
+//
+// Replicas are distributed using the largest remainder method, so that the
+// total always matches the placement's minimum number of replicas. Ties are
+// broken by the order of the city codes.
+func getCityReplicas(placement computev1alpha.WorkloadPlacement, cityCodes []string) map[string]int32 {
+	cityReplicas := make(map[string]int32, len(cityCodes))
+
+	distribution := placement.ReplicaDistribution
+	if distribution == nil {
+		for _, cityCode := range cityCodes {
+			cityReplicas[cityCode] = getCityScaleSettings(placement, cityCode).MinReplicas
+		}
+		return cityReplicas
+	}
+
+	weights := make([]int64, len(cityCodes))
+	var totalWeight int64
+	for i, cityCode := range cityCodes {
+		weights[i] = 1
+		if distribution.Type == computev1alpha.WeightedReplicaDistributionType {
+			weights[i] = 0
+			for _, w := range distribution.Weights {
+				if w.CityCode == cityCode {
+					weights[i] = int64(w.Weight)
+					break
+				}
+			}
+		}
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		for _, cityCode := range cityCodes {
+			cityReplicas[cityCode] = 0
+		}
+		return cityReplicas
+	}
+
+	total := int64(placement.ScaleSettings.MinReplicas)
+	remainders := make([]int64, len(cityCodes))
+	assigned := int64(0)
+	for i, cityCode := range cityCodes {
+		share := total * weights[i] / totalWeight
+		remainders[i] = total * weights[i] % totalWeight
+		cityReplicas[cityCode] = int32(share)
+		assigned += share
+	}
+
+	order := make([]int, len(cityCodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+
+	for _, i := range order[:total-assigned] {
+		cityReplicas[cityCodes[i]]++
+	}
+
+	return cityReplicas
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestGetCityReplicas(t *testing.T) {
+	tests := []struct {
+		name                 string
+		minReplicas          int32
+		cityScaleSettings    []computev1alpha.CityScaleSettings
+		distribution         *computev1alpha.ReplicaDistribution
+		cityCodes            []string
+		expectedCityReplicas map[string]int32
+	}{
+		{
+			name:                 "no distribution",
+			minReplicas:          10,
+			cityCodes:            []string{"DFW", "LHR", "FRA"},
+			expectedCityReplicas: map[string]int32{"DFW": 10, "LHR": 10, "FRA": 10},
+		},
+		{
+			name:        "city scale settings",
+			minReplicas: 2,
+			cityScaleSettings: []computev1alpha.CityScaleSettings{
+				{
+					CityCode: "DFW",
+					ScaleSettings: computev1alpha.HorizontalScaleSettings{
+						MinReplicas: 20,
+					},
+				},
+			},
+			cityCodes:            []string{"DFW", "LHR"},
+			expectedCityReplicas: map[string]int32{"DFW": 20, "LHR": 2},
+		},
+		{
+			name:        "even distribution",
+			minReplicas: 10,
+			distribution: &computev1alpha.ReplicaDistribution{
+				Type: computev1alpha.EvenReplicaDistributionType,
+			},
+			cityCodes:            []string{"DFW", "LHR", "FRA"},
+			expectedCityReplicas: map[string]int32{"DFW": 4, "LHR": 3, "FRA": 3},
+		},
+		{
+			name:        "even distribution with fewer replicas than cities",
+			minReplicas: 2,
+			distribution: &computev1alpha.ReplicaDistribution{
+				Type: computev1alpha.EvenReplicaDistributionType,
+			},
+			cityCodes:            []string{"DFW", "LHR", "FRA"},
+			expectedCityReplicas: map[string]int32{"DFW": 1, "LHR": 1, "FRA": 0},
+		},
+		{
+			name:        "weighted distribution",
+			minReplicas: 10,
+			distribution: &computev1alpha.ReplicaDistribution{
+				Type: computev1alpha.WeightedReplicaDistributionType,
+				Weights: []computev1alpha.CityReplicaWeight{
+					{CityCode: "DFW", Weight: 1},
+					{CityCode: "LHR", Weight: 2},
+					{CityCode: "FRA", Weight: 3},
+				},
+			},
+			cityCodes:            []string{"DFW", "LHR", "FRA"},
+			expectedCityReplicas: map[string]int32{"DFW": 2, "LHR": 3, "FRA": 5},
+		},
+		{
+			name:        "weighted distribution rebalances when a city is removed",
+			minReplicas: 10,
+			distribution: &computev1alpha.ReplicaDistribution{
+				Type: computev1alpha.WeightedReplicaDistributionType,
+				Weights: []computev1alpha.CityReplicaWeight{
+					{CityCode: "DFW", Weight: 1},
+					{CityCode: "LHR", Weight: 2},
+					{CityCode: "FRA", Weight: 3},
+				},
+			},
+			cityCodes:            []string{"DFW", "FRA"},
+			expectedCityReplicas: map[string]int32{"DFW": 3, "FRA": 7},
+		},
+		{
+			name:        "weighted distribution without weights for available cities",
+			minReplicas: 10,
+			distribution: &computev1alpha.ReplicaDistribution{
+				Type: computev1alpha.WeightedReplicaDistributionType,
+				Weights: []computev1alpha.CityReplicaWeight{
+					{CityCode: "LHR", Weight: 1},
+				},
+			},
+			cityCodes:            []string{"DFW"},
+			expectedCityReplicas: map[string]int32{"DFW": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placement := computev1alpha.WorkloadPlacement{
+				ScaleSettings: computev1alpha.HorizontalScaleSettings{
+					MinReplicas: tt.minReplicas,
+				},
+				CityScaleSettings:   tt.cityScaleSettings,
+				ReplicaDistribution: tt.distribution,
+			}
+
+			cityReplicas := getCityReplicas(placement, tt.cityCodes)
+			assert.Equal(t, tt.expectedCityReplicas, cityReplicas)
+		})
+	}
+}
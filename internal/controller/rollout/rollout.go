@@ -0,0 +1,235 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/controller/instancecontrol"
+)
+
+const (
+	// DefaultSoakSeconds is the number of seconds a wave must be ready before
+	// the next wave is started when not provided in the rollout strategy.
+	DefaultSoakSeconds = 300
+
+	// DefaultProgressDeadlineSeconds is the number of seconds a wave may take to
+	// become ready when not provided in the rollout strategy.
+	DefaultProgressDeadlineSeconds = 600
+)
+
+// Result is the outcome of planning a progressive rollout.
+type Result struct {
+	// Status is the rollout status to be recorded on the workload.
+	Status *v1alpha.WorkloadRolloutStatus
+
+	// Condition is the Progressing condition to be recorded on the workload.
+	Condition metav1.Condition
+
+	// RequeueAfter is the duration after which the rollout should be planned
+	// again, such as when waiting for a soak period to complete.
+	RequeueAfter time.Duration
+}
+
+// Plan determines which of the desired deployments for a workload may receive
+// their desired instance template. Deployments in waves which have not yet
+// been started will retain the instance template of the existing deployment.
+//
+// Deployments which do not yet exist always receive the desired instance
+// template, as there are no instances to protect from a bad change.
+//
+// Plan returns nil when the workload does not use a progressive rollout
+// strategy.
+func Plan(
+	workload *v1alpha.Workload,
+	desired []v1alpha.WorkloadDeployment,
+	existing []v1alpha.WorkloadDeployment,
+	now time.Time,
+) *Result {
+	strategy := workload.Spec.RolloutStrategy
+	if strategy == nil || strategy.Type != v1alpha.ProgressiveWorkloadRolloutStrategyType {
+		return nil
+	}
+
+	progressive := strategy.Progressive
+	if progressive == nil {
+		progressive = &v1alpha.ProgressiveRolloutStrategy{}
+	}
+
+	soak := time.Duration(ptr.Deref(progressive.SoakSeconds, DefaultSoakSeconds)) * time.Second
+	deadline := time.Duration(ptr.Deref(progressive.ProgressDeadlineSeconds, DefaultProgressDeadlineSeconds)) * time.Second
+
+	existingDeployments := make(map[string]*v1alpha.WorkloadDeployment, len(existing))
+	for i := range existing {
+		existingDeployments[existing[i].Name] = &existing[i]
+	}
+
+	holdWaves := func(waves [][]int) {
+		for _, wave := range waves {
+			for _, i := range wave {
+				if existingDeployment, ok := existingDeployments[desired[i].Name]; ok {
+					desired[i].Spec.Template = existingDeployment.Spec.Template
+
+					// The deployment retains the revision number of its template.
+					if revision, ok := existingDeployment.Annotations[v1alpha.TemplateRevisionAnnotation]; ok {
+						if desired[i].Annotations == nil {
+							desired[i].Annotations = map[string]string{}
+						}
+						desired[i].Annotations[v1alpha.TemplateRevisionAnnotation] = revision
+					}
+				}
+			}
+		}
+	}
+
+	waves := getWaves(desired, progressive.WaveBy)
+	templateHash := instancecontrol.WorkloadTemplateHash(workload)
+
+	status := workload.Status.Rollout.DeepCopy()
+	halted := false
+	if status == nil || status.TemplateHash != templateHash {
+		// A new instance template is being rolled out.
+		status = &v1alpha.WorkloadRolloutStatus{
+			TemplateHash:  templateHash,
+			WaveStartTime: &metav1.Time{Time: now},
+		}
+	} else if condition := apimeta.FindStatusCondition(workload.Status.Conditions, v1alpha.WorkloadProgressing); condition != nil {
+		halted = condition.Status == metav1.ConditionFalse && condition.Reason == "RolloutHalted"
+	}
+	status.Waves = int32(len(waves))
+	status.CurrentWave = min(status.CurrentWave, status.Waves)
+
+	result := &Result{
+		Status: status,
+		Condition: metav1.Condition{
+			Type:   v1alpha.WorkloadProgressing,
+			Status: metav1.ConditionTrue,
+		},
+	}
+
+	if halted {
+		// The rollout remains halted until a new instance template is provided.
+		holdWaves(waves[min(int(status.CurrentWave)+1, len(waves)):])
+		result.Condition = *apimeta.FindStatusCondition(workload.Status.Conditions, v1alpha.WorkloadProgressing)
+		return result
+	}
+
+	if workload.Spec.Paused {
+		// The progress deadline restarts when the workload is resumed.
+		holdWaves(waves[min(int(status.CurrentWave)+1, len(waves)):])
+		status.WaveStartTime = nil
+		status.WaveReadyTime = nil
+		result.Condition.Reason = "RolloutPaused"
+		result.Condition.Message = fmt.Sprintf("Rollout is paused at wave %d of %d", status.CurrentWave+1, status.Waves)
+		return result
+	}
+
+	for i := int(status.CurrentWave); i < len(waves); i++ {
+		if status.WaveStartTime == nil {
+			status.WaveStartTime = &metav1.Time{Time: now}
+		}
+
+		if !waveReady(waves[i], desired, existingDeployments) {
+			holdWaves(waves[i+1:])
+			status.WaveReadyTime = nil
+
+			elapsed := now.Sub(status.WaveStartTime.Time)
+			if elapsed >= deadline {
+				result.Condition.Status = metav1.ConditionFalse
+				result.Condition.Reason = "RolloutHalted"
+				result.Condition.Message = fmt.Sprintf("Wave %d of %d did not become ready within %s", i+1, len(waves), deadline)
+				return result
+			}
+
+			result.Condition.Reason = "WaveInProgress"
+			result.Condition.Message = fmt.Sprintf("Waiting for wave %d of %d to become ready", i+1, len(waves))
+			result.RequeueAfter = deadline - elapsed
+			return result
+		}
+
+		if status.WaveReadyTime == nil {
+			status.WaveReadyTime = &metav1.Time{Time: now}
+		}
+
+		if elapsed := now.Sub(status.WaveReadyTime.Time); elapsed < soak {
+			holdWaves(waves[i+1:])
+			result.Condition.Reason = "WaveSoaking"
+			result.Condition.Message = fmt.Sprintf("Wave %d of %d is ready and soaking", i+1, len(waves))
+			result.RequeueAfter = soak - elapsed
+			return result
+		}
+
+		status.CurrentWave = int32(i + 1)
+		status.WaveStartTime = nil
+		status.WaveReadyTime = nil
+	}
+
+	result.Condition.Reason = "RolloutComplete"
+	result.Condition.Message = "The instance template has been rolled out to all deployments"
+	return result
+}
+
+// getWaves groups the desired deployments into waves, retaining the order in
+// which deployments are first observed. Each wave contains the indexes of its
+// deployments.
+func getWaves(desired []v1alpha.WorkloadDeployment, waveBy v1alpha.RolloutWaveType) [][]int {
+	var waves [][]int
+	waveIndexes := map[string]int{}
+	for i, deployment := range desired {
+		key := deployment.Spec.CityCode
+		if waveBy == v1alpha.PlacementRolloutWaveType {
+			key = deployment.Spec.PlacementName
+		}
+
+		waveIndex, ok := waveIndexes[key]
+		if !ok {
+			waveIndex = len(waves)
+			waveIndexes[key] = waveIndex
+			waves = append(waves, nil)
+		}
+
+		waves[waveIndex] = append(waves[waveIndex], i)
+	}
+
+	return waves
+}
+
+// waveReady returns true when all deployments in the wave have been updated
+// with their desired instance template, and all of their instances have been
+// updated and are ready. Instances which have been intentionally stopped are
+// not expected to become ready.
+func waveReady(
+	wave []int,
+	desired []v1alpha.WorkloadDeployment,
+	existingDeployments map[string]*v1alpha.WorkloadDeployment,
+) bool {
+	for _, i := range wave {
+		existingDeployment, ok := existingDeployments[desired[i].Name]
+		if !ok {
+			return false
+		}
+
+		if instancecontrol.ComputeHash(existingDeployment.Spec.Template) != instancecontrol.ComputeHash(desired[i].Spec.Template) {
+			return false
+		}
+
+		// Deployments which have not been scheduled do not have any instances.
+		if existingDeployment.Status.Location == nil {
+			continue
+		}
+
+		status := existingDeployment.Status
+		if status.ObservedGeneration != existingDeployment.Generation ||
+			status.Replicas != status.DesiredReplicas ||
+			status.CurrentReplicas != status.Replicas ||
+			status.ReadyReplicas+status.StoppedReplicas != status.Replicas {
+			return false
+		}
+	}
+
+	return true
+}
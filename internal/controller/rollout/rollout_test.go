@@ -0,0 +1,252 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+var now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestAllAtOnceRollout(t *testing.T) {
+	workload := getWorkload(nil)
+	oldTemplate := getTemplate("old")
+
+	desired := getDesiredDeployments(workload, "dfw", "lhr")
+	existing := []v1alpha.WorkloadDeployment{
+		getExistingDeployment(desired[0], oldTemplate, true),
+		getExistingDeployment(desired[1], oldTemplate, true),
+	}
+
+	assert.Nil(t, Plan(workload, desired, existing, now))
+	assert.Equal(t, workload.Spec.Template, desired[0].Spec.Template)
+	assert.Equal(t, workload.Spec.Template, desired[1].Spec.Template)
+}
+
+func TestProgressiveRolloutStartsFirstWave(t *testing.T) {
+	workload := getWorkload(&v1alpha.ProgressiveRolloutStrategy{})
+	oldTemplate := getTemplate("old")
+
+	desired := getDesiredDeployments(workload, "dfw", "lhr")
+	existing := []v1alpha.WorkloadDeployment{
+		getExistingDeployment(desired[0], oldTemplate, true),
+		getExistingDeployment(desired[1], oldTemplate, true),
+	}
+
+	result := Plan(workload, desired, existing, now)
+
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int32(0), result.Status.CurrentWave)
+		assert.Equal(t, int32(2), result.Status.Waves)
+		assert.Equal(t, "WaveInProgress", result.Condition.Reason)
+		assert.Equal(t, DefaultProgressDeadlineSeconds*time.Second, result.RequeueAfter)
+	}
+
+	assert.Equal(t, workload.Spec.Template, desired[0].Spec.Template)
+	assert.Equal(t, oldTemplate, desired[1].Spec.Template)
+}
+
+func TestProgressiveRolloutWaitsForSoak(t *testing.T) {
+	workload := getWorkload(&v1alpha.ProgressiveRolloutStrategy{
+		SoakSeconds: ptr.To(int32(60)),
+	})
+	oldTemplate := getTemplate("old")
+
+	desired := getDesiredDeployments(workload, "dfw", "lhr")
+	existing := []v1alpha.WorkloadDeployment{
+		getExistingDeployment(desired[0], workload.Spec.Template, true),
+		getExistingDeployment(desired[1], oldTemplate, true),
+	}
+
+	result := Plan(workload, desired, existing, now)
+
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int32(0), result.Status.CurrentWave)
+		assert.Equal(t, "WaveSoaking", result.Condition.Reason)
+		assert.Equal(t, 60*time.Second, result.RequeueAfter)
+	}
+	assert.Equal(t, oldTemplate, desired[1].Spec.Template)
+
+	// The next wave is started once the soak period has passed.
+	workload.Status.Rollout = result.Status
+	desired = getDesiredDeployments(workload, "dfw", "lhr")
+
+	result = Plan(workload, desired, existing, now.Add(60*time.Second))
+
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int32(1), result.Status.CurrentWave)
+		assert.Equal(t, "WaveInProgress", result.Condition.Reason)
+	}
+	assert.Equal(t, workload.Spec.Template, desired[1].Spec.Template)
+}
+
+func TestProgressiveRolloutHaltsAfterDeadline(t *testing.T) {
+	workload := getWorkload(&v1alpha.ProgressiveRolloutStrategy{
+		ProgressDeadlineSeconds: ptr.To(int32(60)),
+	})
+	oldTemplate := getTemplate("old")
+
+	desired := getDesiredDeployments(workload, "dfw", "lhr")
+	existing := []v1alpha.WorkloadDeployment{
+		getExistingDeployment(desired[0], workload.Spec.Template, false),
+		getExistingDeployment(desired[1], oldTemplate, true),
+	}
+
+	result := Plan(workload, desired, existing, now)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "WaveInProgress", result.Condition.Reason)
+	}
+
+	workload.Status.Rollout = result.Status
+	desired = getDesiredDeployments(workload, "dfw", "lhr")
+
+	result = Plan(workload, desired, existing, now.Add(60*time.Second))
+	if assert.NotNil(t, result) {
+		assert.Equal(t, metav1.ConditionFalse, result.Condition.Status)
+		assert.Equal(t, "RolloutHalted", result.Condition.Reason)
+	}
+	assert.Equal(t, oldTemplate, desired[1].Spec.Template)
+
+	// The rollout remains halted, even if the wave later becomes ready.
+	workload.Status.Rollout = result.Status
+	apimeta.SetStatusCondition(&workload.Status.Conditions, result.Condition)
+	existing[0] = getExistingDeployment(desired[0], workload.Spec.Template, true)
+	desired = getDesiredDeployments(workload, "dfw", "lhr")
+
+	result = Plan(workload, desired, existing, now.Add(time.Hour))
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "RolloutHalted", result.Condition.Reason)
+	}
+	assert.Equal(t, oldTemplate, desired[1].Spec.Template)
+
+	// A new template starts a new rollout.
+	workload.Spec.Template = getTemplate("fixed")
+	desired = getDesiredDeployments(workload, "dfw", "lhr")
+
+	result = Plan(workload, desired, existing, now.Add(time.Hour))
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int32(0), result.Status.CurrentWave)
+		assert.Equal(t, "WaveInProgress", result.Condition.Reason)
+	}
+	assert.Equal(t, workload.Spec.Template, desired[0].Spec.Template)
+}
+
+func TestProgressiveRolloutNewDeployments(t *testing.T) {
+	workload := getWorkload(&v1alpha.ProgressiveRolloutStrategy{})
+
+	// Deployments which do not yet exist receive the latest template.
+	desired := getDesiredDeployments(workload, "dfw", "lhr")
+	result := Plan(workload, desired, nil, now)
+
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "WaveInProgress", result.Condition.Reason)
+	}
+	assert.Equal(t, workload.Spec.Template, desired[0].Spec.Template)
+	assert.Equal(t, workload.Spec.Template, desired[1].Spec.Template)
+}
+
+func TestGetWaves(t *testing.T) {
+	workload := getWorkload(nil)
+	desired := append(
+		getDesiredDeployments(workload, "dfw", "lhr"),
+		v1alpha.WorkloadDeployment{
+			Spec: v1alpha.WorkloadDeploymentSpec{
+				PlacementName: "secondary",
+				CityCode:      "dfw",
+			},
+		},
+	)
+
+	assert.Equal(t, [][]int{{0, 2}, {1}}, getWaves(desired, v1alpha.CityRolloutWaveType))
+	assert.Equal(t, [][]int{{0, 1}, {2}}, getWaves(desired, v1alpha.PlacementRolloutWaveType))
+}
+
+func getWorkload(progressive *v1alpha.ProgressiveRolloutStrategy) *v1alpha.Workload {
+	workload := &v1alpha.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: v1alpha.WorkloadSpec{
+			Template: getTemplate("new"),
+		},
+	}
+
+	if progressive != nil {
+		workload.Spec.RolloutStrategy = &v1alpha.WorkloadRolloutStrategy{
+			Type:        v1alpha.ProgressiveWorkloadRolloutStrategyType,
+			Progressive: progressive,
+		}
+	}
+
+	return workload
+}
+
+func getTemplate(image string) v1alpha.InstanceTemplateSpec {
+	return v1alpha.InstanceTemplateSpec{
+		Spec: v1alpha.InstanceSpec{
+			Runtime: v1alpha.InstanceRuntimeSpec{
+				Sandbox: &v1alpha.SandboxRuntime{
+					Containers: []v1alpha.SandboxContainer{
+						{
+							Name:  "test",
+							Image: image,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDesiredDeployments(workload *v1alpha.Workload, cityCodes ...string) []v1alpha.WorkloadDeployment {
+	var deployments []v1alpha.WorkloadDeployment
+	for _, cityCode := range cityCodes {
+		deployments = append(deployments, v1alpha.WorkloadDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-default-" + cityCode,
+				Namespace: "default",
+			},
+			Spec: v1alpha.WorkloadDeploymentSpec{
+				PlacementName: "default",
+				CityCode:      cityCode,
+				Template:      workload.Spec.Template,
+			},
+		})
+	}
+	return deployments
+}
+
+func getExistingDeployment(
+	desired v1alpha.WorkloadDeployment,
+	template v1alpha.InstanceTemplateSpec,
+	ready bool,
+) v1alpha.WorkloadDeployment {
+	deployment := *desired.DeepCopy()
+	deployment.Generation = 2
+	deployment.Spec.Template = template
+	deployment.Status = v1alpha.WorkloadDeploymentStatus{
+		ObservedGeneration: 2,
+		Location: &networkingv1alpha.LocationReference{
+			Namespace: "default",
+			Name:      desired.Spec.CityCode,
+		},
+		Replicas:        2,
+		CurrentReplicas: 2,
+		DesiredReplicas: 2,
+		ReadyReplicas:   2,
+	}
+
+	if !ready {
+		deployment.Status.ReadyReplicas = 1
+	}
+
+	return deployment
+}
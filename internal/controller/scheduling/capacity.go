@@ -0,0 +1,132 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/controller/instancecontrol"
+)
+
+// CapacityPluginName is the name of the Capacity plugin.
+const CapacityPluginName = "Capacity"
+
+// Capacity filters out locations which do not have enough capacity for the
+// deployment's instances, as declared by LocationCapacities. The instances of
+// every deployment scheduled to a location are counted against its capacity.
+//
+// Deployments which may be autoscaled reserve their maximum number of
+// replicas, so that they may scale without exceeding the location's capacity.
+type Capacity struct {
+	client client.Client
+
+	// limits and allocated are keyed by the location's namespace and name, then
+	// the instance type. Both are calculated once per scheduling cycle.
+	limits    map[string]map[string]int32
+	allocated map[string]map[string]int32
+}
+
+var _ FilterPlugin = &Capacity{}
+
+// NewCapacity returns a Capacity plugin.
+func NewCapacity(c client.Client) Plugin {
+	return &Capacity{
+		client: c,
+	}
+}
+
+func (p *Capacity) Name() string {
+	return CapacityPluginName
+}
+
+func (p *Capacity) Filter(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	location *networkingv1alpha.Location,
+) (*Status, error) {
+	if p.limits == nil {
+		if err := p.calculateCapacity(ctx, deployment); err != nil {
+			return nil, err
+		}
+	}
+
+	key := locationKey(location.Namespace, location.Name)
+	instanceType := deployment.Spec.Template.Spec.Runtime.Resources.InstanceType
+
+	limit, ok := p.limits[key][instanceType]
+	if !ok {
+		return nil, nil
+	}
+
+	requested := reservedReplicas(deployment)
+	available := max(0, limit-p.allocated[key][instanceType])
+	if requested > available {
+		return &Status{
+			Reason: "InsufficientCapacity",
+			Message: fmt.Sprintf(
+				"%d %s instances requested, %d of %d available",
+				requested, instanceType, available, limit,
+			),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (p *Capacity) calculateCapacity(ctx context.Context, deployment *v1alpha.WorkloadDeployment) error {
+	var locationCapacities v1alpha.LocationCapacityList
+	if err := p.client.List(ctx, &locationCapacities); err != nil {
+		return fmt.Errorf("failed listing location capacities: %w", err)
+	}
+
+	p.limits = map[string]map[string]int32{}
+	for _, locationCapacity := range locationCapacities.Items {
+		locationRef := locationCapacity.Spec.LocationRef
+		key := locationKey(locationRef.Namespace, locationRef.Name)
+		if p.limits[key] == nil {
+			p.limits[key] = map[string]int32{}
+		}
+
+		for _, instanceTypeCapacity := range locationCapacity.Spec.Instances {
+			p.limits[key][instanceTypeCapacity.InstanceType] += instanceTypeCapacity.Limit
+		}
+	}
+
+	var deployments v1alpha.WorkloadDeploymentList
+	if err := p.client.List(ctx, &deployments); err != nil {
+		return fmt.Errorf("failed listing workload deployments: %w", err)
+	}
+
+	p.allocated = map[string]map[string]int32{}
+	for _, d := range deployments.Items {
+		if d.UID == deployment.UID || d.Status.Location == nil {
+			continue
+		}
+
+		key := locationKey(d.Status.Location.Namespace, d.Status.Location.Name)
+		if p.allocated[key] == nil {
+			p.allocated[key] = map[string]int32{}
+		}
+
+		// Instances which still exist are counted even if they are no longer
+		// desired, as they continue to consume capacity until removed.
+		instanceType := d.Spec.Template.Spec.Runtime.Resources.InstanceType
+		p.allocated[key][instanceType] += max(d.Status.Replicas, reservedReplicas(&d))
+	}
+
+	return nil
+}
+
+// reservedReplicas returns the number of instances reserved for the deployment
+// in its location. Deployments with a maximum number of replicas reserve the
+// maximum, otherwise the desired number of replicas is reserved.
+func reservedReplicas(deployment *v1alpha.WorkloadDeployment) int32 {
+	replicas := instancecontrol.DesiredReplicas(deployment)
+	if maxReplicas := deployment.Spec.ScaleSettings.MaxReplicas; maxReplicas != nil {
+		replicas = max(replicas, *maxReplicas)
+	}
+	return replicas
+}
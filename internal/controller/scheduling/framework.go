@@ -0,0 +1,265 @@
+package scheduling
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/config"
+)
+
+// MaxScore is the maximum score a ScorePlugin may return for a location.
+const MaxScore int64 = 100
+
+// Plugin is the parent type for all scheduling plugins.
+type Plugin interface {
+	Name() string
+}
+
+// FilterPlugin removes locations which a deployment may not be scheduled to.
+type FilterPlugin interface {
+	Plugin
+
+	// Filter returns a nil Status if the deployment may be scheduled to the
+	// location, otherwise a Status explaining why it may not be.
+	Filter(ctx context.Context, deployment *v1alpha.WorkloadDeployment, location *networkingv1alpha.Location) (*Status, error)
+}
+
+// ScorePlugin ranks locations which have passed all filter plugins.
+type ScorePlugin interface {
+	Plugin
+
+	// Score returns a score between 0 and MaxScore for the location. Higher
+	// scores are preferred.
+	Score(ctx context.Context, deployment *v1alpha.WorkloadDeployment, location *networkingv1alpha.Location) (int64, error)
+}
+
+// Status explains why a filter plugin rejected a location.
+type Status struct {
+	// Reason is a CamelCase reason for the rejection.
+	Reason string
+
+	// Message is a human readable explanation of the rejection.
+	Message string
+}
+
+// PluginFactory creates a plugin which uses the provided client to access
+// resources in the cluster that the deployment being scheduled belongs to.
+// Plugins are created for each scheduling cycle.
+type PluginFactory func(c client.Client) Plugin
+
+// Registry contains the plugins which may be configured for the scheduler.
+var Registry = map[string]PluginFactory{
+	LocationHealthPluginName: NewLocationHealth,
+	TopologyPluginName:       NewTopology,
+	InstanceTypePluginName:   NewInstanceType,
+	CapacityPluginName:       NewCapacity,
+	LeastLoadedPluginName:    NewLeastLoaded,
+}
+
+// RequiredPlugins are run before all other plugins, even when they are not
+// configured. Deployments are moved out of locations which these plugins
+// reject, so a deployment must never be scheduled to such a location.
+var RequiredPlugins = []string{
+	LocationHealthPluginName,
+	TopologyPluginName,
+}
+
+// Framework runs the configured filter and score plugins to select a location
+// for a deployment.
+type Framework struct {
+	plugins []config.SchedulerPluginConfig
+}
+
+// NewFramework returns a Framework for the provided configuration. An error is
+// returned if the configuration refers to a plugin that is not registered.
+func NewFramework(cfg config.SchedulerConfig) (*Framework, error) {
+	var plugins []config.SchedulerPluginConfig
+	for _, name := range RequiredPlugins {
+		if !slices.ContainsFunc(cfg.Plugins, func(pluginConfig config.SchedulerPluginConfig) bool {
+			return pluginConfig.Name == name
+		}) {
+			plugins = append(plugins, config.SchedulerPluginConfig{Name: name, Weight: 1})
+		}
+	}
+
+	for _, pluginConfig := range cfg.Plugins {
+		if _, ok := Registry[pluginConfig.Name]; !ok {
+			return nil, fmt.Errorf("unknown scheduler plugin %q", pluginConfig.Name)
+		}
+		plugins = append(plugins, pluginConfig)
+	}
+
+	return &Framework{
+		plugins: plugins,
+	}, nil
+}
+
+// PluginEnabled returns true if the framework runs the plugin.
+func (f *Framework) PluginEnabled(name string) bool {
+	return slices.ContainsFunc(f.plugins, func(pluginConfig config.SchedulerPluginConfig) bool {
+		return pluginConfig.Name == name
+	})
+}
+
+// Result is the outcome of a scheduling cycle.
+type Result struct {
+	// SelectedLocation is the location selected for the deployment, or nil if
+	// no location passed all filter plugins.
+	SelectedLocation *networkingv1alpha.Location
+
+	// Conditions describe the result of each plugin, and should be recorded on
+	// the deployment.
+	Conditions []metav1.Condition
+}
+
+// PluginConditionType returns the condition type used to record the result of
+// a plugin on a deployment.
+func PluginConditionType(pluginName string) string {
+	return "Scheduler" + pluginName
+}
+
+// Schedule selects a location for the deployment from the provided locations.
+func (f *Framework) Schedule(
+	ctx context.Context,
+	c client.Client,
+	deployment *v1alpha.WorkloadDeployment,
+	locations []networkingv1alpha.Location,
+) (*Result, error) {
+	result := &Result{}
+
+	// Sort locations so that ties in scores are broken consistently.
+	candidates := slices.Clone(locations)
+	slices.SortFunc(candidates, func(a, b networkingv1alpha.Location) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	type scorePlugin struct {
+		plugin ScorePlugin
+		weight int64
+	}
+	var scorePlugins []scorePlugin
+
+	for _, pluginConfig := range f.plugins {
+		plugin := Registry[pluginConfig.Name](c)
+
+		if scorer, ok := plugin.(ScorePlugin); ok {
+			scorePlugins = append(scorePlugins, scorePlugin{plugin: scorer, weight: pluginConfig.Weight})
+		}
+
+		filter, ok := plugin.(FilterPlugin)
+		if !ok {
+			continue
+		}
+
+		var feasible []networkingv1alpha.Location
+		var rejections []string
+		rejectionReasons := map[string]int{}
+		for i := range candidates {
+			status, err := filter.Filter(ctx, deployment, &candidates[i])
+			if err != nil {
+				return nil, fmt.Errorf("plugin %q failed filtering location %q: %w", filter.Name(), candidates[i].Name, err)
+			}
+
+			if status == nil {
+				feasible = append(feasible, candidates[i])
+				continue
+			}
+
+			rejectionReasons[status.Reason]++
+			rejections = append(rejections, fmt.Sprintf("%s: %s", candidates[i].Name, status.Message))
+		}
+
+		condition := metav1.Condition{
+			Type:    PluginConditionType(filter.Name()),
+			Status:  metav1.ConditionTrue,
+			Reason:  "LocationsFeasible",
+			Message: fmt.Sprintf("%d of %d locations passed the filter", len(feasible), len(candidates)),
+		}
+
+		if len(feasible) == 0 {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "NoFeasibleLocations"
+
+			// Report the most common reason that locations were rejected.
+			var maxRejections int
+			for reason, count := range rejectionReasons {
+				if count > maxRejections || (count == maxRejections && reason < condition.Reason) {
+					condition.Reason = reason
+					maxRejections = count
+				}
+			}
+		}
+
+		if len(rejections) > 0 {
+			condition.Message = fmt.Sprintf("%s. Rejected: %s", condition.Message, summarize(rejections))
+		}
+
+		result.Conditions = append(result.Conditions, condition)
+
+		candidates = feasible
+		if len(candidates) == 0 {
+			return result, nil
+		}
+	}
+
+	if len(candidates) == 0 {
+		return result, nil
+	}
+
+	scores := make([]int64, len(candidates))
+	for _, scorer := range scorePlugins {
+		var selectedScore int64
+		for i := range candidates {
+			score, err := scorer.plugin.Score(ctx, deployment, &candidates[i])
+			if err != nil {
+				return nil, fmt.Errorf("plugin %q failed scoring location %q: %w", scorer.plugin.Name(), candidates[i].Name, err)
+			}
+
+			score = max(0, min(score, MaxScore))
+			scores[i] += score * scorer.weight
+			if i == 0 || score > selectedScore {
+				selectedScore = score
+			}
+		}
+
+		result.Conditions = append(result.Conditions, metav1.Condition{
+			Type:    PluginConditionType(scorer.plugin.Name()),
+			Status:  metav1.ConditionTrue,
+			Reason:  "LocationsScored",
+			Message: fmt.Sprintf("Scored %d locations, the highest score was %d of %d", len(candidates), selectedScore, MaxScore),
+		})
+	}
+
+	selected := 0
+	for i, score := range scores {
+		if score > scores[selected] {
+			selected = i
+		}
+	}
+
+	result.SelectedLocation = &candidates[selected]
+	return result, nil
+}
+
+// maxSummarizedRejections is the number of rejected locations to include in
+// condition messages, to avoid exceeding the maximum message length.
+const maxSummarizedRejections = 5
+
+func summarize(rejections []string) string {
+	if len(rejections) <= maxSummarizedRejections {
+		return strings.Join(rejections, "; ")
+	}
+
+	return fmt.Sprintf("%s; and %d more",
+		strings.Join(rejections[:maxSummarizedRejections], "; "),
+		len(rejections)-maxSummarizedRejections,
+	)
+}
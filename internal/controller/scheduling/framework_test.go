@@ -0,0 +1,352 @@
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/config"
+)
+
+func TestNewFrameworkUnknownPlugin(t *testing.T) {
+	_, err := NewFramework(config.SchedulerConfig{
+		Plugins: []config.SchedulerPluginConfig{{Name: "Unknown", Weight: 1}},
+	})
+	assert.ErrorContains(t, err, `unknown scheduler plugin "Unknown"`)
+}
+
+func TestNewFrameworkRequiredPlugins(t *testing.T) {
+	framework, err := NewFramework(config.SchedulerConfig{
+		Plugins: []config.SchedulerPluginConfig{{Name: LeastLoadedPluginName, Weight: 1}},
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// Deployments are evicted from unschedulable locations and locations which
+	// do not match their city code, so they are never selected.
+	unschedulable := getTestLocation("dfw-1", "dfw")
+	unschedulable.Annotations = map[string]string{
+		v1alpha.LocationUnschedulableAnnotation: "true",
+	}
+	locations := []networkingv1alpha.Location{
+		unschedulable,
+		getTestLocation("lhr-1", "lhr"),
+		getTestLocation("dfw-2", "dfw"),
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(getTestScheme()).Build()
+	result, err := framework.Schedule(context.Background(), fakeClient, getTestDeployment("test", "dfw"), locations)
+	assert.NoError(t, err)
+	if assert.NotNil(t, result.SelectedLocation) {
+		assert.Equal(t, "dfw-2", result.SelectedLocation.Name)
+	}
+
+	if assert.Len(t, result.Conditions, 3) {
+		assert.Equal(t, "SchedulerLocationHealth", result.Conditions[0].Type)
+		assert.Equal(t, "SchedulerTopology", result.Conditions[1].Type)
+		assert.Equal(t, "SchedulerLeastLoaded", result.Conditions[2].Type)
+	}
+}
+
+func TestScheduleSelectsLeastLoadedLocation(t *testing.T) {
+	deployment := getTestDeployment("test", "dfw")
+	locations := []networkingv1alpha.Location{
+		getTestLocation("dfw-1", "dfw"),
+		getTestLocation("dfw-2", "dfw"),
+		getTestLocation("lhr-1", "lhr"),
+	}
+
+	busyDeployment := getTestDeployment("busy", "dfw")
+	busyDeployment.Status = v1alpha.WorkloadDeploymentStatus{
+		Location: &networkingv1alpha.LocationReference{
+			Namespace: "default",
+			Name:      "dfw-1",
+		},
+		Replicas: 3,
+	}
+
+	result, err := schedule(t, deployment, locations, busyDeployment)
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, result.SelectedLocation) {
+		assert.Equal(t, "dfw-2", result.SelectedLocation.Name)
+	}
+
+	if assert.Len(t, result.Conditions, 5) {
+		assert.Equal(t, "SchedulerLocationHealth", result.Conditions[0].Type)
+		assert.Equal(t, metav1.ConditionTrue, result.Conditions[0].Status)
+
+		assert.Equal(t, "SchedulerTopology", result.Conditions[1].Type)
+		assert.Equal(t, "LocationsFeasible", result.Conditions[1].Reason)
+		assert.Contains(t, result.Conditions[1].Message, "2 of 3 locations passed the filter")
+
+		assert.Equal(t, "SchedulerInstanceType", result.Conditions[2].Type)
+		assert.Equal(t, metav1.ConditionTrue, result.Conditions[2].Status)
+
+		assert.Equal(t, "SchedulerCapacity", result.Conditions[3].Type)
+		assert.Equal(t, metav1.ConditionTrue, result.Conditions[3].Status)
+
+		assert.Equal(t, "SchedulerLeastLoaded", result.Conditions[4].Type)
+		assert.Equal(t, "LocationsScored", result.Conditions[4].Reason)
+	}
+}
+
+func TestScheduleNoFeasibleLocations(t *testing.T) {
+	deployment := getTestDeployment("test", "dfw")
+
+	notReady := getTestLocation("dfw-1", "dfw")
+	notReady.Status.Conditions = []metav1.Condition{
+		{
+			Type:    "Ready",
+			Status:  metav1.ConditionFalse,
+			Reason:  "Maintenance",
+			Message: "location is under maintenance",
+		},
+	}
+
+	locations := []networkingv1alpha.Location{
+		notReady,
+		getTestLocation("lhr-1", "lhr"),
+	}
+
+	result, err := schedule(t, deployment, locations)
+	assert.NoError(t, err)
+	assert.Nil(t, result.SelectedLocation)
+
+	// Filtering stops once no locations remain, and score plugins are not run.
+	if assert.Len(t, result.Conditions, 2) {
+		assert.Equal(t, metav1.ConditionTrue, result.Conditions[0].Status)
+		assert.Contains(t, result.Conditions[0].Message, "dfw-1: location is under maintenance")
+
+		assert.Equal(t, metav1.ConditionFalse, result.Conditions[1].Status)
+		assert.Equal(t, "CityCodeMismatch", result.Conditions[1].Reason)
+	}
+}
+
+func TestScheduleInsufficientCapacity(t *testing.T) {
+	deployment := getTestDeployment("test", "dfw")
+	deployment.Spec.ScaleSettings.MinReplicas = 2
+
+	locations := []networkingv1alpha.Location{
+		getTestLocation("dfw-1", "dfw"),
+		getTestLocation("dfw-2", "dfw"),
+	}
+
+	// dfw-1 has capacity for 4 instances, 3 of which are used by another
+	// deployment.
+	busyDeployment := getTestDeployment("busy", "dfw")
+	busyDeployment.Spec.ScaleSettings.MinReplicas = 3
+	busyDeployment.Status.Location = &networkingv1alpha.LocationReference{
+		Namespace: "default",
+		Name:      "dfw-1",
+	}
+
+	// dfw-2 has capacity for 1 instance.
+	capacities := []client.Object{
+		getTestLocationCapacity("dfw-1", 4),
+		getTestLocationCapacity("dfw-2", 1),
+	}
+
+	result, err := schedule(t, deployment, locations, append(capacities, busyDeployment)...)
+	assert.NoError(t, err)
+	assert.Nil(t, result.SelectedLocation)
+
+	if assert.Len(t, result.Conditions, 4) {
+		assert.Equal(t, "SchedulerCapacity", result.Conditions[3].Type)
+		assert.Equal(t, metav1.ConditionFalse, result.Conditions[3].Status)
+		assert.Equal(t, "InsufficientCapacity", result.Conditions[3].Reason)
+		assert.Contains(t, result.Conditions[3].Message, "dfw-1: 2 datumcloud/d1-standard-2 instances requested, 1 of 4 available")
+	}
+
+	// Once the other deployment scales down, the deployment fits in dfw-1.
+	busyDeployment.Spec.ScaleSettings.MinReplicas = 2
+
+	result, err = schedule(t, deployment, locations, append(capacities, busyDeployment)...)
+	assert.NoError(t, err)
+	if assert.NotNil(t, result.SelectedLocation) {
+		assert.Equal(t, "dfw-1", result.SelectedLocation.Name)
+	}
+}
+
+func TestTopologyFilterLocationSelector(t *testing.T) {
+	deployment := getTestDeployment("test", "dfw")
+	deployment.Spec.LocationSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"topology.datum.net/provider": "gcp",
+		},
+	}
+
+	location := getTestLocation("dfw-1", "dfw")
+
+	status, err := (&Topology{}).Filter(context.Background(), deployment, &location)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, "LocationSelectorMismatch", status.Reason)
+	}
+
+	location.Spec.Topology["topology.datum.net/provider"] = "gcp"
+
+	status, err = (&Topology{}).Filter(context.Background(), deployment, &location)
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestInstanceTypeFilter(t *testing.T) {
+	deployment := getTestDeployment("test", "dfw")
+	location := getTestLocation("dfw-1", "dfw")
+
+	instanceType := getTestInstanceType()
+	instanceType.Spec.Locations = []networkingv1alpha.LocationReference{
+		{Namespace: "default", Name: "dfw-2"},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(getTestScheme()).
+		WithObjects(instanceType).
+		Build()
+
+	status, err := NewInstanceType(fakeClient).(FilterPlugin).Filter(context.Background(), deployment, &location)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, "InstanceTypeNotOffered", status.Reason)
+	}
+
+	location.Name = "dfw-2"
+	status, err = NewInstanceType(fakeClient).(FilterPlugin).Filter(context.Background(), deployment, &location)
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+
+	deployment.Spec.Template.Spec.Runtime.Resources.InstanceType = "datumcloud/d1-standard-4"
+	status, err = NewInstanceType(fakeClient).(FilterPlugin).Filter(context.Background(), deployment, &location)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, "InstanceTypeNotFound", status.Reason)
+	}
+}
+
+func TestInstanceTypeFilterEmptyCatalog(t *testing.T) {
+	deployment := getTestDeployment("test", "dfw")
+	location := getTestLocation("dfw-1", "dfw")
+
+	// Instance types must be declared even when no instance types exist.
+	fakeClient := fake.NewClientBuilder().WithScheme(getTestScheme()).Build()
+	status, err := NewInstanceType(fakeClient).(FilterPlugin).Filter(context.Background(), deployment, &location)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, "InstanceTypeNotFound", status.Reason)
+	}
+}
+
+func schedule(
+	t *testing.T,
+	deployment *v1alpha.WorkloadDeployment,
+	locations []networkingv1alpha.Location,
+	objs ...client.Object,
+) (*Result, error) {
+	t.Helper()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(getTestScheme()).
+		WithObjects(append(objs, getTestInstanceType())...).
+		Build()
+
+	cfg := config.SchedulerConfig{}
+	config.SetDefaults_SchedulerConfig(&cfg)
+	for i := range cfg.Plugins {
+		config.SetDefaults_SchedulerPluginConfig(&cfg.Plugins[i])
+	}
+
+	framework, err := NewFramework(cfg)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return framework.Schedule(context.Background(), fakeClient, deployment, locations)
+}
+
+func getTestScheme() *k8sruntime.Scheme {
+	scheme := k8sruntime.NewScheme()
+	utilruntime.Must(v1alpha.AddToScheme(scheme))
+	utilruntime.Must(networkingv1alpha.AddToScheme(scheme))
+	return scheme
+}
+
+func getTestDeployment(name, cityCode string) *v1alpha.WorkloadDeployment {
+	return &v1alpha.WorkloadDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			UID:       types.UID(name),
+		},
+		Spec: v1alpha.WorkloadDeploymentSpec{
+			CityCode: cityCode,
+			Template: v1alpha.InstanceTemplateSpec{
+				Spec: v1alpha.InstanceSpec{
+					Runtime: v1alpha.InstanceRuntimeSpec{
+						Resources: v1alpha.InstanceRuntimeResources{
+							InstanceType: "datumcloud/d1-standard-2",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getTestLocation(name, cityCode string) networkingv1alpha.Location {
+	return networkingv1alpha.Location{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Spec: networkingv1alpha.LocationSpec{
+			Topology: map[string]string{
+				v1alpha.CityCodeTopologyKey: cityCode,
+			},
+		},
+	}
+}
+
+func getTestLocationCapacity(locationName string, limit int32) *v1alpha.LocationCapacity {
+	return &v1alpha.LocationCapacity{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      locationName,
+		},
+		Spec: v1alpha.LocationCapacitySpec{
+			LocationRef: networkingv1alpha.LocationReference{
+				Namespace: "default",
+				Name:      locationName,
+			},
+			Instances: []v1alpha.InstanceTypeCapacity{
+				{
+					InstanceType: "datumcloud/d1-standard-2",
+					Limit:        limit,
+				},
+			},
+		},
+	}
+}
+
+func getTestInstanceType() *v1alpha.InstanceType {
+	return &v1alpha.InstanceType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "datumcloud.d1-standard-2",
+		},
+		Spec: v1alpha.InstanceTypeSpec{
+			Runtimes: []v1alpha.InstanceRuntimeType{
+				v1alpha.SandboxInstanceRuntimeType,
+			},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// InstanceTypePluginName is the name of the InstanceType plugin.
+const InstanceTypePluginName = "InstanceType"
+
+// InstanceType filters out locations which do not offer the deployment's
+// instance type, as declared by its InstanceType. An instance type which does
+// not declare any locations is offered by all locations.
+type InstanceType struct {
+	client client.Client
+
+	// instanceType is retrieved once per scheduling cycle, and is nil when the
+	// deployment's instance type has not been declared.
+	instanceType *v1alpha.InstanceType
+	retrieved    bool
+}
+
+var _ FilterPlugin = &InstanceType{}
+
+// NewInstanceType returns an InstanceType plugin.
+func NewInstanceType(c client.Client) Plugin {
+	return &InstanceType{
+		client: c,
+	}
+}
+
+func (p *InstanceType) Name() string {
+	return InstanceTypePluginName
+}
+
+func (p *InstanceType) Filter(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	location *networkingv1alpha.Location,
+) (*Status, error) {
+	instanceTypeName := deployment.Spec.Template.Spec.Runtime.Resources.InstanceType
+
+	if !p.retrieved {
+		var instanceType v1alpha.InstanceType
+		if err := p.client.Get(ctx, client.ObjectKey{Name: v1alpha.InstanceTypeName(instanceTypeName)}, &instanceType); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed fetching instance type: %w", err)
+			}
+		} else {
+			p.instanceType = &instanceType
+		}
+		p.retrieved = true
+	}
+
+	if p.instanceType == nil {
+		return &Status{
+			Reason:  "InstanceTypeNotFound",
+			Message: fmt.Sprintf("instance type %s has not been declared", instanceTypeName),
+		}, nil
+	}
+
+	locations := p.instanceType.Spec.Locations
+	if len(locations) > 0 && !slices.ContainsFunc(locations, func(locationRef networkingv1alpha.LocationReference) bool {
+		return locationRef.Namespace == location.Namespace && locationRef.Name == location.Name
+	}) {
+		return &Status{
+			Reason:  "InstanceTypeNotOffered",
+			Message: fmt.Sprintf("location does not offer instance type %s", instanceTypeName),
+		}, nil
+	}
+
+	return nil, nil
+}
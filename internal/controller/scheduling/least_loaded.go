@@ -0,0 +1,82 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// LeastLoadedPluginName is the name of the LeastLoaded plugin.
+const LeastLoadedPluginName = "LeastLoaded"
+
+// LeastLoaded prefers locations which are running the fewest instances. Scores
+// are relative to the most loaded location, which receives a score of 0.
+type LeastLoaded struct {
+	client client.Client
+
+	// loads is the number of instances in each location, keyed by the
+	// location's namespace and name. Loads are calculated once per scheduling
+	// cycle.
+	loads   map[string]int64
+	maxLoad int64
+}
+
+var _ ScorePlugin = &LeastLoaded{}
+
+// NewLeastLoaded returns a LeastLoaded plugin.
+func NewLeastLoaded(c client.Client) Plugin {
+	return &LeastLoaded{
+		client: c,
+	}
+}
+
+func (p *LeastLoaded) Name() string {
+	return LeastLoadedPluginName
+}
+
+func (p *LeastLoaded) Score(
+	ctx context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	location *networkingv1alpha.Location,
+) (int64, error) {
+	if p.loads == nil {
+		if err := p.calculateLoads(ctx, deployment); err != nil {
+			return 0, err
+		}
+	}
+
+	if p.maxLoad == 0 {
+		return MaxScore, nil
+	}
+
+	load := p.loads[locationKey(location.Namespace, location.Name)]
+	return MaxScore * (p.maxLoad - load) / p.maxLoad, nil
+}
+
+func (p *LeastLoaded) calculateLoads(ctx context.Context, deployment *v1alpha.WorkloadDeployment) error {
+	var deployments v1alpha.WorkloadDeploymentList
+	if err := p.client.List(ctx, &deployments); err != nil {
+		return fmt.Errorf("failed listing workload deployments: %w", err)
+	}
+
+	p.loads = map[string]int64{}
+	for _, d := range deployments.Items {
+		if d.UID == deployment.UID || d.Status.Location == nil {
+			continue
+		}
+
+		key := locationKey(d.Status.Location.Namespace, d.Status.Location.Name)
+		p.loads[key] += int64(d.Status.Replicas)
+		p.maxLoad = max(p.maxLoad, p.loads[key])
+	}
+
+	return nil
+}
+
+func locationKey(namespace, name string) string {
+	return namespace + "/" + name
+}
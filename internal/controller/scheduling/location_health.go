@@ -0,0 +1,75 @@
+package scheduling
+
+import (
+	"context"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// LocationHealthPluginName is the name of the LocationHealth plugin.
+const LocationHealthPluginName = "LocationHealth"
+
+// locationReadyConditionType is the condition type that reflects whether a
+// location is able to accept workloads.
+const locationReadyConditionType = "Ready"
+
+// LocationHealth filters out locations which are being deleted, have been
+// marked as unschedulable, or have reported that they are not ready. Locations
+// which have not reported their readiness are considered healthy.
+type LocationHealth struct{}
+
+var _ FilterPlugin = &LocationHealth{}
+
+// NewLocationHealth returns a LocationHealth plugin.
+func NewLocationHealth(_ client.Client) Plugin {
+	return &LocationHealth{}
+}
+
+func (p *LocationHealth) Name() string {
+	return LocationHealthPluginName
+}
+
+func (p *LocationHealth) Filter(
+	_ context.Context,
+	_ *v1alpha.WorkloadDeployment,
+	location *networkingv1alpha.Location,
+) (*Status, error) {
+	if !location.DeletionTimestamp.IsZero() {
+		return &Status{
+			Reason:  "LocationTerminating",
+			Message: "location is being deleted",
+		}, nil
+	}
+
+	if LocationUnschedulable(location) {
+		return &Status{
+			Reason:  "LocationUnschedulable",
+			Message: "location has been marked unschedulable",
+		}, nil
+	}
+
+	readyCondition := apimeta.FindStatusCondition(location.Status.Conditions, locationReadyConditionType)
+	if readyCondition != nil && readyCondition.Status == metav1.ConditionFalse {
+		message := "location is not ready"
+		if readyCondition.Message != "" {
+			message = readyCondition.Message
+		}
+		return &Status{
+			Reason:  "LocationNotReady",
+			Message: message,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// LocationUnschedulable returns true if the location has been marked as
+// unschedulable.
+func LocationUnschedulable(location *networkingv1alpha.Location) bool {
+	return location.Annotations[v1alpha.LocationUnschedulableAnnotation] == "true"
+}
@@ -0,0 +1,85 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	"go.datum.net/workload-operator/api/v1alpha"
+)
+
+// TopologyPluginName is the name of the Topology plugin.
+const TopologyPluginName = "Topology"
+
+// Topology filters out locations which are not in the deployment's city, or
+// whose topology does not match the deployment's location selector.
+type Topology struct{}
+
+var _ FilterPlugin = &Topology{}
+
+// NewTopology returns a Topology plugin.
+func NewTopology(_ client.Client) Plugin {
+	return &Topology{}
+}
+
+func (p *Topology) Name() string {
+	return TopologyPluginName
+}
+
+func (p *Topology) Filter(
+	_ context.Context,
+	deployment *v1alpha.WorkloadDeployment,
+	location *networkingv1alpha.Location,
+) (*Status, error) {
+	selector, err := LocationSelectorAsSelector(deployment.Spec.LocationSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if location.Spec.Topology[v1alpha.CityCodeTopologyKey] != deployment.Spec.CityCode {
+		return &Status{
+			Reason:  "CityCodeMismatch",
+			Message: fmt.Sprintf("location is not in city %q", deployment.Spec.CityCode),
+		}, nil
+	}
+
+	if !selector.Matches(labels.Set(location.Spec.Topology)) {
+		return &Status{
+			Reason:  "LocationSelectorMismatch",
+			Message: "location topology does not match the location selector",
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// LocationSelectorAsSelector converts a location selector into a selector that
+// may be matched against the topology of locations. A nil location selector
+// matches all locations.
+func LocationSelectorAsSelector(locationSelector *metav1.LabelSelector) (labels.Selector, error) {
+	if locationSelector == nil {
+		return labels.Everything(), nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(locationSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing location selector: %w", err)
+	}
+
+	return selector, nil
+}
+
+// LocationMatches returns true if the location resides in the city, and its
+// topology matches the selector.
+func LocationMatches(location networkingv1alpha.Location, cityCode string, selector labels.Selector) bool {
+	locationCityCode, ok := location.Spec.Topology[v1alpha.CityCodeTopologyKey]
+	if !ok || locationCityCode != cityCode {
+		return false
+	}
+
+	return selector.Matches(labels.Set(location.Spec.Topology))
+}
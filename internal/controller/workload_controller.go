@@ -6,15 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -23,6 +30,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
 	mccontext "sigs.k8s.io/multicluster-runtime/pkg/context"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
@@ -30,10 +39,16 @@ import (
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/controller/instancecontrol"
+	"go.datum.net/workload-operator/internal/controller/rollout"
 )
 
 const workloadControllerFinalizer = "compute.datumapis.com/workload-controller"
 
+// gatewayBackendManagedBy is the value of the managed-by label on
+// EndpointSlices created for named ports referenced by a workload gateway.
+const gatewayBackendManagedBy = "compute.datumapis.com/workload-controller"
+
 // WorkloadReconciler reconciles a Workload object
 type WorkloadReconciler struct {
 	mgr        mcmanager.Manager
@@ -43,6 +58,11 @@ type WorkloadReconciler struct {
 // +kubebuilder:rbac:groups=compute.datumapis.com,resources=workloads,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=compute.datumapis.com,resources=workloads/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=compute.datumapis.com,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=compute.datumapis.com,resources=instancerevisions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;update;patch;delete
 
 func (r *WorkloadReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
@@ -87,6 +107,14 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req mcreconcile.Requ
 	logger.Info("reconciling workload")
 	defer logger.Info("reconcile complete")
 
+	if workload.Spec.RollbackTo != nil {
+		// The update to the workload will result in another reconcile.
+		if err := r.rollbackWorkload(ctx, cl.GetClient(), &workload); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed rolling back workload: %w", err)
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// TODO(jreese) perform extra validation on the workload now that it's been
 	// created.
 	//
@@ -142,13 +170,27 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req mcreconcile.Requ
 	// we could run multiple versions of an operator at the same time and
 	// incrementally promote resources to newer versions.
 
-	desired, orphaned, err := r.getDeploymentsForWorkload(ctx, cl.GetClient(), &workload)
+	if err := r.reconcileTemplateRevision(ctx, cl.GetClient(), &workload); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed reconciling template revision: %w", err)
+	}
+
+	desired, orphaned, placementCityCodes, err := r.getDeploymentsForWorkload(ctx, cl.GetClient(), &workload)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed getting deployments for workload: %w", err)
 	}
 
+	rolloutResult, err := r.planRollout(ctx, cl.GetClient(), &workload, desired)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed planning rollout: %w", err)
+	}
+
 	placementDeployments := make(map[string][]computev1alpha.WorkloadDeployment)
 
+	// Ensure status is reported for placements which have no deployments.
+	for _, placement := range workload.Spec.Placements {
+		placementDeployments[placement.Name] = nil
+	}
+
 	if len(orphaned) > 0 {
 		for _, deployment := range orphaned {
 			if deployment.DeletionTimestamp.IsZero() {
@@ -202,7 +244,567 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req mcreconcile.Requ
 		)
 	}
 
-	return ctrl.Result{}, r.reconcileWorkloadStatus(ctx, cl.GetClient(), &workload, placementDeployments)
+	gatewayStatus, err := r.reconcileGateway(ctx, cl.GetClient(), &workload)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed reconciling workload gateway: %w", err)
+	}
+
+	if err := r.reconcileWorkloadStatus(ctx, cl.GetClient(), &workload, placementDeployments, placementCityCodes, gatewayStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var result ctrl.Result
+	if rolloutResult != nil {
+		result.RequeueAfter = rolloutResult.RequeueAfter
+	}
+
+	return result, nil
+}
+
+// planRollout determines which desired deployments may receive the workload's
+// instance template when a progressive rollout strategy is in use. The
+// template of deployments that are held back is replaced in the desired
+// deployments, and the rollout status is recorded on the workload.
+func (r *WorkloadReconciler) planRollout(
+	ctx context.Context,
+	upstreamClient client.Client,
+	workload *computev1alpha.Workload,
+	desired []computev1alpha.WorkloadDeployment,
+) (*rollout.Result, error) {
+	logger := log.FromContext(ctx)
+
+	listOpts := client.MatchingFields{
+		deploymentWorkloadUIDIndex: string(workload.UID),
+	}
+	var deployments computev1alpha.WorkloadDeploymentList
+	if err := upstreamClient.List(ctx, &deployments, listOpts); err != nil {
+		return nil, fmt.Errorf("failed listing deployments: %w", err)
+	}
+
+	result := rollout.Plan(workload, desired, deployments.Items, time.Now())
+	if result == nil {
+		workload.Status.Rollout = nil
+		apimeta.RemoveStatusCondition(&workload.Status.Conditions, computev1alpha.WorkloadProgressing)
+		return nil, nil
+	}
+
+	logger.Info("planned rollout", "current_wave", result.Status.CurrentWave, "waves", result.Status.Waves, "reason", result.Condition.Reason)
+
+	workload.Status.Rollout = result.Status
+	apimeta.SetStatusCondition(&workload.Status.Conditions, result.Condition)
+
+	return result, nil
+}
+
+// reconcileTemplateRevision assigns a new revision number to the workload's
+// instance template when it has changed. The revision number is recorded in
+// the workload's status when the status is updated, and is used for the
+// revisions created by each of the workload's deployments, so that a revision
+// number captures the same instance template across deployments.
+func (r *WorkloadReconciler) reconcileTemplateRevision(
+	ctx context.Context,
+	c client.Client,
+	workload *computev1alpha.Workload,
+) error {
+	if workload.Status.TemplateHash == instancecontrol.WorkloadTemplateHash(workload) {
+		return nil
+	}
+
+	var revisions computev1alpha.InstanceRevisionList
+	listOpts := []client.ListOption{
+		client.InNamespace(workload.Namespace),
+		client.MatchingLabels{
+			computev1alpha.WorkloadUIDLabel: string(workload.UID),
+		},
+	}
+
+	if err := c.List(ctx, &revisions, listOpts...); err != nil {
+		return fmt.Errorf("failed listing instance revisions: %w", err)
+	}
+
+	workload.Status.TemplateRevision, workload.Status.TemplateHash = instancecontrol.WorkloadTemplateRevision(workload, revisions.Items)
+	log.FromContext(ctx).Info("assigned template revision", "revision_number", workload.Status.TemplateRevision)
+
+	return nil
+}
+
+// rollbackWorkload replaces the workload's instance template with the template
+// captured by the requested revision, and clears the rollback request. The
+// result of the rollback is communicated via the RolledBack condition.
+func (r *WorkloadReconciler) rollbackWorkload(
+	ctx context.Context,
+	c client.Client,
+	workload *computev1alpha.Workload,
+) error {
+	logger := log.FromContext(ctx)
+
+	var revisions computev1alpha.InstanceRevisionList
+	listOpts := []client.ListOption{
+		client.InNamespace(workload.Namespace),
+		client.MatchingLabels{
+			computev1alpha.WorkloadUIDLabel: string(workload.UID),
+		},
+	}
+
+	if err := c.List(ctx, &revisions, listOpts...); err != nil {
+		return fmt.Errorf("failed listing instance revisions: %w", err)
+	}
+
+	revision, err := instancecontrol.FindRollbackRevision(
+		revisions.Items,
+		workload.Spec.RollbackTo.Revision,
+		instancecontrol.ComputeHash(workload.Spec.Template),
+	)
+
+	condition := metav1.Condition{
+		Type: computev1alpha.WorkloadRolledBack,
+	}
+
+	if err != nil {
+		logger.Info("unable to roll back workload", "reason", err.Error())
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RevisionNotFound"
+		condition.Message = fmt.Sprintf("Unable to roll back: %s", err)
+	} else {
+		logger.Info("rolling back workload", "revision", revision.Name, "revision_number", revision.Revision)
+		workload.Spec.Template = revision.Template
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "RollbackComplete"
+		condition.Message = fmt.Sprintf("Rolled back to revision %d", revision.Revision)
+	}
+
+	workload.Spec.RollbackTo = nil
+	if err := c.Update(ctx, workload); err != nil {
+		return fmt.Errorf("failed updating workload: %w", err)
+	}
+
+	condition.ObservedGeneration = workload.Generation
+	apimeta.SetStatusCondition(&workload.Status.Conditions, condition)
+	if err := c.Status().Update(ctx, workload); err != nil {
+		return fmt.Errorf("failed updating workload status: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileGateway ensures that the Gateway and TCPRoutes defined by the
+// workload exist, and removes any that are no longer desired. The status of
+// the gateway is returned so that it may be reflected on the workload.
+func (r *WorkloadReconciler) reconcileGateway(
+	ctx context.Context,
+	upstreamClient client.Client,
+	workload *computev1alpha.Workload,
+) (*computev1alpha.WorkloadGatewayStatus, error) {
+	logger := log.FromContext(ctx)
+
+	gatewayName := fmt.Sprintf("%s-gateway", workload.Name)
+	desiredRoutes := sets.Set[string]{}
+
+	backendServices, err := r.reconcileGatewayBackends(ctx, upstreamClient, workload)
+	if err != nil {
+		return nil, err
+	}
+
+	var gatewayStatus *computev1alpha.WorkloadGatewayStatus
+	if workloadGateway := workload.Spec.Gateway; workloadGateway != nil {
+		gateway := &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: workload.Namespace,
+				Name:      gatewayName,
+			},
+		}
+
+		result, err := controllerutil.CreateOrUpdate(ctx, upstreamClient, gateway, func() error {
+			if gateway.CreationTimestamp.IsZero() {
+				if err := controllerutil.SetControllerReference(workload, gateway, upstreamClient.Scheme()); err != nil {
+					return fmt.Errorf("failed to set controller on gateway: %w", err)
+				}
+			}
+
+			if gateway.Labels == nil {
+				gateway.Labels = map[string]string{}
+			}
+			gateway.Labels[computev1alpha.WorkloadUIDLabel] = string(workload.UID)
+
+			gateway.Spec = *workloadGateway.Template.Spec.DeepCopy()
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed mutating gateway: %w", err)
+		}
+
+		logger.Info("gateway processed", "gateway_name", gateway.Name, "operation_result", result)
+
+		for _, tcpRouteSpec := range workloadGateway.TCPRoutes {
+			tcpRoute := &gatewayv1alpha2.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: workload.Namespace,
+					Name:      fmt.Sprintf("%s-%s", gatewayName, tcpRouteSpec.Name),
+				},
+			}
+			desiredRoutes.Insert(tcpRoute.Name)
+
+			result, err := controllerutil.CreateOrUpdate(ctx, upstreamClient, tcpRoute, func() error {
+				if tcpRoute.CreationTimestamp.IsZero() {
+					if err := controllerutil.SetControllerReference(workload, tcpRoute, upstreamClient.Scheme()); err != nil {
+						return fmt.Errorf("failed to set controller on tcp route: %w", err)
+					}
+				}
+
+				if tcpRoute.Labels == nil {
+					tcpRoute.Labels = map[string]string{}
+				}
+				tcpRoute.Labels[computev1alpha.WorkloadUIDLabel] = string(workload.UID)
+
+				tcpRoute.Spec = gatewayv1alpha2.TCPRouteSpec{
+					CommonRouteSpec: *tcpRouteSpec.CommonRouteSpec.DeepCopy(),
+				}
+
+				// Parent references on the workload are placeholders for the gateway
+				// created for the workload.
+				for j := range tcpRoute.Spec.ParentRefs {
+					tcpRoute.Spec.ParentRefs[j].Name = gatewayv1.ObjectName(gatewayName)
+				}
+
+				for _, rule := range tcpRouteSpec.Rules {
+					rule := rule.DeepCopy()
+
+					// Backend references on the workload refer to named ports, which are
+					// resolved to the service created for the port.
+					for k, backendRef := range rule.BackendRefs {
+						service, ok := backendServices[string(backendRef.Name)]
+						if ptr.Deref(backendRef.Kind, "") != computev1alpha.NamedPortKind || !ok {
+							continue
+						}
+
+						rule.BackendRefs[k].BackendObjectReference = gatewayv1.BackendObjectReference{
+							Group: ptr.To(gatewayv1.Group(corev1.GroupName)),
+							Kind:  ptr.To(gatewayv1.Kind("Service")),
+							Name:  gatewayv1.ObjectName(service.Name),
+							Port:  ptr.To(gatewayv1.PortNumber(service.Spec.Ports[0].Port)),
+						}
+					}
+
+					tcpRoute.Spec.Rules = append(tcpRoute.Spec.Rules, gatewayv1alpha2.TCPRouteRule{
+						Name:        rule.Name,
+						BackendRefs: rule.BackendRefs,
+					})
+				}
+
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed mutating tcp route: %w", err)
+			}
+
+			logger.Info("tcp route processed", "tcp_route_name", tcpRoute.Name, "operation_result", result)
+		}
+
+		gatewayStatus = &computev1alpha.WorkloadGatewayStatus{
+			GatewayStatus: *gateway.Status.DeepCopy(),
+		}
+	}
+
+	// Remove any routes or gateways which are no longer desired.
+	listOpts := []client.ListOption{
+		client.InNamespace(workload.Namespace),
+		client.MatchingLabels{
+			computev1alpha.WorkloadUIDLabel: string(workload.UID),
+		},
+	}
+
+	var tcpRoutes gatewayv1alpha2.TCPRouteList
+	if err := upstreamClient.List(ctx, &tcpRoutes, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed listing tcp routes: %w", err)
+	}
+
+	for _, tcpRoute := range tcpRoutes.Items {
+		if desiredRoutes.Has(tcpRoute.Name) || !tcpRoute.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		logger.Info("deleting tcp route", "tcp_route_name", tcpRoute.Name)
+		if err := upstreamClient.Delete(ctx, &tcpRoute); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed deleting tcp route: %w", err)
+		}
+	}
+
+	if workload.Spec.Gateway == nil {
+		var gateways gatewayv1.GatewayList
+		if err := upstreamClient.List(ctx, &gateways, listOpts...); err != nil {
+			return nil, fmt.Errorf("failed listing gateways: %w", err)
+		}
+
+		for _, gateway := range gateways.Items {
+			if !gateway.DeletionTimestamp.IsZero() {
+				continue
+			}
+
+			logger.Info("deleting gateway", "gateway_name", gateway.Name)
+			if err := upstreamClient.Delete(ctx, &gateway); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed deleting gateway: %w", err)
+			}
+		}
+	}
+
+	return gatewayStatus, nil
+}
+
+// reconcileGatewayBackends ensures that a Service and EndpointSlice exist for
+// each named port referenced by the routes of the workload gateway, and removes
+// any that are no longer referenced. Endpoints are built from the addresses of
+// the workload's instances which define the named port.
+//
+// The returned map contains the service for each referenced named port, keyed
+// by the name of the port.
+func (r *WorkloadReconciler) reconcileGatewayBackends(
+	ctx context.Context,
+	upstreamClient client.Client,
+	workload *computev1alpha.Workload,
+) (map[string]*corev1.Service, error) {
+	logger := log.FromContext(ctx)
+
+	listOpts := []client.ListOption{
+		client.InNamespace(workload.Namespace),
+		client.MatchingLabels{
+			computev1alpha.WorkloadUIDLabel: string(workload.UID),
+		},
+	}
+
+	referencedPorts := sets.Set[string]{}
+	if workloadGateway := workload.Spec.Gateway; workloadGateway != nil {
+		for _, tcpRoute := range workloadGateway.TCPRoutes {
+			for _, rule := range tcpRoute.Rules {
+				for _, backendRef := range rule.BackendRefs {
+					if ptr.Deref(backendRef.Kind, "") == computev1alpha.NamedPortKind {
+						referencedPorts.Insert(string(backendRef.Name))
+					}
+				}
+			}
+		}
+	}
+
+	services := map[string]*corev1.Service{}
+	desiredServices := sets.Set[string]{}
+	if referencedPorts.Len() > 0 {
+		var instances computev1alpha.InstanceList
+		if err := upstreamClient.List(ctx, &instances, listOpts...); err != nil {
+			return nil, fmt.Errorf("failed listing instances: %w", err)
+		}
+
+		namedPorts := instanceNamedPorts(workload.Spec.Template.Spec)
+		for _, portName := range sets.List(referencedPorts) {
+			namedPort, ok := namedPorts[portName]
+			if !ok {
+				continue
+			}
+
+			service, err := r.reconcileNamedPortService(ctx, upstreamClient, workload, namedPort, instances.Items)
+			if err != nil {
+				return nil, err
+			}
+
+			services[portName] = service
+			desiredServices.Insert(service.Name)
+		}
+	}
+
+	var endpointSlices discoveryv1.EndpointSliceList
+	if err := upstreamClient.List(ctx, &endpointSlices, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed listing endpoint slices: %w", err)
+	}
+
+	for _, endpointSlice := range endpointSlices.Items {
+		if desiredServices.Has(endpointSlice.Name) || !endpointSlice.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		logger.Info("deleting endpoint slice", "endpoint_slice_name", endpointSlice.Name)
+		if err := upstreamClient.Delete(ctx, &endpointSlice); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed deleting endpoint slice: %w", err)
+		}
+	}
+
+	var serviceList corev1.ServiceList
+	if err := upstreamClient.List(ctx, &serviceList, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed listing services: %w", err)
+	}
+
+	for _, service := range serviceList.Items {
+		if desiredServices.Has(service.Name) || !service.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		logger.Info("deleting service", "service_name", service.Name)
+		if err := upstreamClient.Delete(ctx, &service); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed deleting service: %w", err)
+		}
+	}
+
+	return services, nil
+}
+
+// reconcileNamedPortService ensures that a headless Service exists for the
+// named port, along with an EndpointSlice containing an endpoint for each
+// instance which defines the same port and has been assigned an address.
+func (r *WorkloadReconciler) reconcileNamedPortService(
+	ctx context.Context,
+	upstreamClient client.Client,
+	workload *computev1alpha.Workload,
+	namedPort computev1alpha.NamedPort,
+	instances []computev1alpha.Instance,
+) (*corev1.Service, error) {
+	logger := log.FromContext(ctx)
+
+	protocol := ptr.Deref(namedPort.Protocol, corev1.ProtocolTCP)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: workload.Namespace,
+			Name:      fmt.Sprintf("%s-%s", workload.Name, namedPort.Name),
+		},
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, upstreamClient, service, func() error {
+		if service.CreationTimestamp.IsZero() {
+			if err := controllerutil.SetControllerReference(workload, service, upstreamClient.Scheme()); err != nil {
+				return fmt.Errorf("failed to set controller on service: %w", err)
+			}
+
+			// Traffic is directed to instances by the gateway, so there's no need
+			// for a cluster IP to be allocated.
+			service.Spec.ClusterIP = corev1.ClusterIPNone
+		}
+
+		if service.Labels == nil {
+			service.Labels = map[string]string{}
+		}
+		service.Labels[computev1alpha.WorkloadUIDLabel] = string(workload.UID)
+
+		// Endpoints are managed by this controller, so the service has no
+		// selector.
+		service.Spec.Selector = nil
+		service.Spec.Ports = []corev1.ServicePort{
+			{
+				Name:       namedPort.Name,
+				Protocol:   protocol,
+				Port:       namedPort.Port,
+				TargetPort: intstr.FromInt32(namedPort.Port),
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed mutating service: %w", err)
+	}
+
+	logger.Info("service processed", "service_name", service.Name, "operation_result", result)
+
+	var endpoints []discoveryv1.Endpoint
+	for _, instance := range instances {
+		instancePort, ok := instanceNamedPorts(instance.Spec)[namedPort.Name]
+		if !ok || instancePort.Port != namedPort.Port {
+			continue
+		}
+
+		address := instanceIPv4Address(&instance)
+		if address == "" {
+			continue
+		}
+
+		ready := apimeta.IsStatusConditionTrue(instance.Status.Conditions, computev1alpha.InstanceReady)
+		terminating := !instance.DeletionTimestamp.IsZero()
+
+		endpoints = append(endpoints, discoveryv1.Endpoint{
+			Addresses: []string{address},
+			Conditions: discoveryv1.EndpointConditions{
+				Ready:       ptr.To(ready && !terminating),
+				Serving:     ptr.To(ready),
+				Terminating: ptr.To(terminating),
+			},
+			TargetRef: &corev1.ObjectReference{
+				APIVersion: computev1alpha.GroupVersion.String(),
+				Kind:       "Instance",
+				Namespace:  instance.Namespace,
+				Name:       instance.Name,
+				UID:        instance.UID,
+			},
+		})
+	}
+
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: workload.Namespace,
+			Name:      service.Name,
+		},
+	}
+
+	result, err = controllerutil.CreateOrUpdate(ctx, upstreamClient, endpointSlice, func() error {
+		if endpointSlice.CreationTimestamp.IsZero() {
+			if err := controllerutil.SetControllerReference(workload, endpointSlice, upstreamClient.Scheme()); err != nil {
+				return fmt.Errorf("failed to set controller on endpoint slice: %w", err)
+			}
+		}
+
+		if endpointSlice.Labels == nil {
+			endpointSlice.Labels = map[string]string{}
+		}
+		endpointSlice.Labels[computev1alpha.WorkloadUIDLabel] = string(workload.UID)
+		endpointSlice.Labels[discoveryv1.LabelServiceName] = service.Name
+		endpointSlice.Labels[discoveryv1.LabelManagedBy] = gatewayBackendManagedBy
+
+		endpointSlice.AddressType = discoveryv1.AddressTypeIPv4
+		endpointSlice.Ports = []discoveryv1.EndpointPort{
+			{
+				Name:     ptr.To(namedPort.Name),
+				Protocol: ptr.To(protocol),
+				Port:     ptr.To(namedPort.Port),
+			},
+		}
+		endpointSlice.Endpoints = endpoints
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed mutating endpoint slice: %w", err)
+	}
+
+	logger.Info("endpoint slice processed", "endpoint_slice_name", endpointSlice.Name, "operation_result", result)
+
+	return service, nil
+}
+
+// instanceNamedPorts returns the named ports defined by the instance spec,
+// keyed by the name of the port.
+func instanceNamedPorts(spec computev1alpha.InstanceSpec) map[string]computev1alpha.NamedPort {
+	namedPorts := map[string]computev1alpha.NamedPort{}
+	if sandbox := spec.Runtime.Sandbox; sandbox != nil {
+		for _, c := range sandbox.Containers {
+			for _, p := range c.Ports {
+				namedPorts[p.Name] = p
+			}
+		}
+	}
+
+	if vm := spec.Runtime.VirtualMachine; vm != nil {
+		for _, p := range vm.Ports {
+			namedPorts[p.Name] = p
+		}
+	}
+
+	return namedPorts
+}
+
+// instanceIPv4Address returns the first IPv4 address assigned to one of the
+// instance's network interfaces, or an empty string if no address has been
+// assigned.
+func instanceIPv4Address(instance *computev1alpha.Instance) string {
+	for _, networkInterface := range instance.Status.NetworkInterfaces {
+		networkIP := ptr.Deref(networkInterface.Assignments.NetworkIP, "")
+		if ip := net.ParseIP(networkIP); ip != nil && ip.To4() != nil {
+			return networkIP
+		}
+	}
+	return ""
 }
 
 func (r *WorkloadReconciler) reconcileWorkloadStatus(
@@ -210,6 +812,8 @@ func (r *WorkloadReconciler) reconcileWorkloadStatus(
 	upstreamClient client.Client,
 	workload *computev1alpha.Workload,
 	placementDeployments map[string][]computev1alpha.WorkloadDeployment,
+	placementCityCodes map[string][]string,
+	gatewayStatus *computev1alpha.WorkloadGatewayStatus,
 ) error {
 	logger := log.FromContext(ctx)
 	logger.Info("reconciling placement status")
@@ -218,6 +822,7 @@ func (r *WorkloadReconciler) reconcileWorkloadStatus(
 	totalCurrentReplicas := int32(0)
 	totalDesiredReplicas := int32(0)
 	totalReadyReplicas := int32(0)
+	totalStoppedReplicas := int32(0)
 	totalDeployments := int32(0)
 
 	availablePlacementFound := false
@@ -249,12 +854,14 @@ func (r *WorkloadReconciler) reconcileWorkloadStatus(
 		currentReplicas := int32(0)
 		desiredReplicas := int32(0)
 		readyReplicas := int32(0)
+		stoppedReplicas := int32(0)
 		totalDeployments += int32(len(placementDeployments))
 		for _, deployment := range placementDeployments {
 			replicas += deployment.Status.Replicas
 			currentReplicas += deployment.Status.CurrentReplicas
 			desiredReplicas += deployment.Status.DesiredReplicas
 			readyReplicas += deployment.Status.ReadyReplicas
+			stoppedReplicas += deployment.Status.StoppedReplicas
 
 			if apimeta.IsStatusConditionTrue(deployment.Status.Conditions, "Available") {
 				foundAvailableDeployment = true
@@ -264,11 +871,13 @@ func (r *WorkloadReconciler) reconcileWorkloadStatus(
 		totalCurrentReplicas += currentReplicas
 		totalDesiredReplicas += desiredReplicas
 		totalReadyReplicas += readyReplicas
+		totalStoppedReplicas += stoppedReplicas
 
 		placementStatus.Replicas = replicas
 		placementStatus.CurrentReplicas = currentReplicas
 		placementStatus.DesiredReplicas = desiredReplicas
 		placementStatus.ReadyReplicas = readyReplicas
+		placementStatus.StoppedReplicas = stoppedReplicas
 
 		if foundAvailableDeployment {
 			placementAvailableCondition.Status = metav1.ConditionTrue
@@ -279,6 +888,16 @@ func (r *WorkloadReconciler) reconcileWorkloadStatus(
 
 		apimeta.SetStatusCondition(&placementStatus.Conditions, placementAvailableCondition)
 
+		for _, placement := range workload.Spec.Placements {
+			if placement.Name == placementName {
+				apimeta.SetStatusCondition(
+					&placementStatus.Conditions,
+					getPlacementCityCodesCondition(placement, placementCityCodes[placementName]),
+				)
+				break
+			}
+		}
+
 		newWorkloadStatus.Placements = append(newWorkloadStatus.Placements, placementStatus)
 	}
 
@@ -297,11 +916,28 @@ func (r *WorkloadReconciler) reconcileWorkloadStatus(
 
 	apimeta.SetStatusCondition(&newWorkloadStatus.Conditions, availableCondition)
 
+	pausedCondition := metav1.Condition{
+		Type:    computev1alpha.WorkloadPaused,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Resumed",
+		Message: "Rollouts are not paused",
+	}
+
+	if workload.Spec.Paused {
+		pausedCondition.Status = metav1.ConditionTrue
+		pausedCondition.Reason = "Paused"
+		pausedCondition.Message = "Changes to the instance template will not be rolled out until the workload is resumed"
+	}
+
+	apimeta.SetStatusCondition(&newWorkloadStatus.Conditions, pausedCondition)
+
 	newWorkloadStatus.Deployments = totalDeployments
 	newWorkloadStatus.Replicas = totalReplicas
 	newWorkloadStatus.CurrentReplicas = totalCurrentReplicas
 	newWorkloadStatus.DesiredReplicas = totalDesiredReplicas
 	newWorkloadStatus.ReadyReplicas = totalReadyReplicas
+	newWorkloadStatus.StoppedReplicas = totalStoppedReplicas
+	newWorkloadStatus.Gateway = gatewayStatus
 
 	if equality.Semantic.DeepEqual(workload.Status, newWorkloadStatus) {
 		return nil
@@ -361,19 +997,25 @@ func (r *WorkloadReconciler) Finalize(ctx context.Context, obj client.Object) (f
 
 // getDeploymentsForWorkload returns both deployments that are desired to exist
 // for a workload, and deployments that have been orphaned and should be
-// removed.
+// removed. The city codes resolved for each placement are also returned, keyed
+// by placement name.
 func (r *WorkloadReconciler) getDeploymentsForWorkload(
 	ctx context.Context,
 	upstreamClient client.Client,
 	workload *computev1alpha.Workload,
-) (desired []computev1alpha.WorkloadDeployment, orphaned []computev1alpha.WorkloadDeployment, err error) {
+) (
+	desired []computev1alpha.WorkloadDeployment,
+	orphaned []computev1alpha.WorkloadDeployment,
+	placementCityCodes map[string][]string,
+	err error,
+) {
 
 	listOpts := client.MatchingFields{
 		deploymentWorkloadUIDIndex: string(workload.UID),
 	}
 	var deployments computev1alpha.WorkloadDeploymentList
 	if err := upstreamClient.List(ctx, &deployments, listOpts); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	existingDeployments := sets.Set[string]{}
@@ -385,28 +1027,30 @@ func (r *WorkloadReconciler) getDeploymentsForWorkload(
 
 	var locations networkingv1alpha.LocationList
 	if err := upstreamClient.List(ctx, &locations); err != nil {
-		return nil, nil, fmt.Errorf("failed to list locations: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to list locations: %w", err)
 	}
 
 	if len(locations.Items) == 0 {
-		return nil, nil, fmt.Errorf("no locations are registered with the system")
+		return nil, nil, nil, fmt.Errorf("no locations are registered with the system")
 	}
 
+	placementCityCodes = make(map[string][]string, len(workload.Spec.Placements))
+
 	// Remember this: namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	for _, placement := range workload.Spec.Placements {
-		for _, cityCode := range placement.CityCodes {
-			foundLocation := false
-			for _, location := range locations.Items {
-				locationCityCode, ok := location.Spec.Topology["topology.datum.net/city-code"]
-				if ok && cityCode == locationCityCode {
-					foundLocation = true
-					break
-				}
-			}
+		cityCodes, err := getPlacementCityCodes(placement, locations.Items)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed getting city codes for placement %q: %w", placement.Name, err)
+		}
+		placementCityCodes[placement.Name] = cityCodes
+
+		cityReplicas := getCityReplicas(placement, cityCodes)
 
-			if !foundLocation {
-				// TODO(jreese) update status condition on placement if no locations are
-				// found.
+		for _, cityCode := range cityCodes {
+			replicas := cityReplicas[cityCode]
+			if replicas == 0 {
+				// Cities which receive no replicas from the placement's replica
+				// distribution do not have a deployment.
 				continue
 			}
 
@@ -417,12 +1061,28 @@ func (r *WorkloadReconciler) getDeploymentsForWorkload(
 			deploymentName := fmt.Sprintf("%s-%s-%s", workload.Name, placement.Name, strings.ToLower(cityCode))
 			desiredDeployments.Insert(deploymentName)
 
+			template, overridden, err := instancecontrol.ApplyTemplateOverrides(workload.Spec.Template, placement.TemplateOverrides, cityCode)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed applying template overrides for placement %q in city %q: %w", placement.Name, cityCode, err)
+			}
+
+			labels := map[string]string{
+				computev1alpha.WorkloadUIDLabel: string(workload.UID),
+			}
+			if overridden {
+				labels[computev1alpha.TemplateOverriddenLabel] = "true"
+			}
+
+			scaleSettings := getCityScaleSettings(placement, cityCode)
+			scaleSettings.MinReplicas = replicas
+
 			desired = append(desired, computev1alpha.WorkloadDeployment{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: workload.Namespace,
 					Name:      deploymentName,
-					Labels: map[string]string{
-						computev1alpha.WorkloadUIDLabel: string(workload.UID),
+					Labels:    labels,
+					Annotations: map[string]string{
+						computev1alpha.TemplateRevisionAnnotation: strconv.FormatInt(workload.Status.TemplateRevision, 10),
 					},
 				},
 				Spec: computev1alpha.WorkloadDeploymentSpec{
@@ -430,10 +1090,15 @@ func (r *WorkloadReconciler) getDeploymentsForWorkload(
 						Name: workload.Name,
 						UID:  workload.UID,
 					},
-					PlacementName: placement.Name,
-					CityCode:      cityCode,
-					Template:      workload.Spec.Template,
-					ScaleSettings: placement.ScaleSettings,
+					PlacementName:        placement.Name,
+					CityCode:             cityCode,
+					LocationSelector:     placement.LocationSelector,
+					Template:             template,
+					ScaleSettings:        scaleSettings,
+					RevisionHistoryLimit: workload.Spec.RevisionHistoryLimit,
+					Paused:               workload.Spec.Paused,
+					Suspend:              workload.Spec.Suspend || placement.Suspend,
+					Restart:              workload.Spec.Restart,
 				},
 			})
 		}
@@ -448,7 +1113,7 @@ func (r *WorkloadReconciler) getDeploymentsForWorkload(
 		}
 	}
 
-	return desired, orphaned, nil
+	return desired, orphaned, placementCityCodes, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -463,6 +1128,16 @@ func (r *WorkloadReconciler) SetupWithManager(mgr mcmanager.Manager) error {
 	return mcbuilder.ControllerManagedBy(mgr).
 		For(&computev1alpha.Workload{}, mcbuilder.WithEngageWithLocalCluster(false)).
 		Owns(&computev1alpha.WorkloadDeployment{}, mcbuilder.WithEngageWithLocalCluster(false)).
+		Owns(&gatewayv1.Gateway{}, mcbuilder.WithEngageWithLocalCluster(false)).
+		Owns(&gatewayv1alpha2.TCPRoute{}, mcbuilder.WithEngageWithLocalCluster(false)).
+		Owns(&corev1.Service{}, mcbuilder.WithEngageWithLocalCluster(false)).
+		Owns(&discoveryv1.EndpointSlice{}, mcbuilder.WithEngageWithLocalCluster(false)).
+		Watches(&computev1alpha.Instance{}, func(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+			return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []mcreconcile.Request {
+				instance := o.(*computev1alpha.Instance)
+				return enqueueWorkloadByInstance(ctx, mgr, clusterName, instance)
+			})
+		}).
 		Watches(&networkingv1alpha.Network{}, func(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
 			return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, network client.Object) []mcreconcile.Request {
 				logger := log.FromContext(ctx)
@@ -501,5 +1176,112 @@ func (r *WorkloadReconciler) SetupWithManager(mgr mcmanager.Manager) error {
 				return requests
 			})
 		}).
+		Watches(&networkingv1alpha.Location{}, func(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+			return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []mcreconcile.Request {
+				location := o.(*networkingv1alpha.Location)
+				return enqueueWorkloadsByLocation(ctx, mgr, clusterName, location)
+			})
+		}).
 		Complete(r)
 }
+
+// enqueueWorkloadByInstance returns a request for the workload which the
+// instance belongs to, so that gateway backends reflect the instance's address
+// and readiness.
+func enqueueWorkloadByInstance(
+	ctx context.Context,
+	mgr mcmanager.Manager,
+	clusterName string,
+	instance *computev1alpha.Instance,
+) []mcreconcile.Request {
+	logger := log.FromContext(ctx)
+
+	ownerRef := metav1.GetControllerOf(instance)
+	if ownerRef == nil || ownerRef.Kind != "WorkloadDeployment" {
+		return nil
+	}
+
+	cluster, err := mgr.GetCluster(ctx, clusterName)
+	if err != nil {
+		logger.Error(err, "failed to get cluster")
+		return nil
+	}
+
+	var deployment computev1alpha.WorkloadDeployment
+	deploymentKey := types.NamespacedName{
+		Namespace: instance.Namespace,
+		Name:      ownerRef.Name,
+	}
+	if err := cluster.GetClient().Get(ctx, deploymentKey, &deployment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to get workload deployment")
+		}
+		return nil
+	}
+
+	return []mcreconcile.Request{
+		{
+			Request: reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: deployment.Namespace,
+					Name:      deployment.Spec.WorkloadRef.Name,
+				},
+			},
+			ClusterName: clusterName,
+		},
+	}
+}
+
+// enqueueWorkloadsByLocation returns requests for workloads with placements
+// that may be satisfied by the location. Both the previous and new versions of
+// an updated location are mapped, so workloads are also enqueued when a
+// location's city code changes.
+func enqueueWorkloadsByLocation(
+	ctx context.Context,
+	mgr mcmanager.Manager,
+	clusterName string,
+	location *networkingv1alpha.Location,
+) []mcreconcile.Request {
+	logger := log.FromContext(ctx)
+
+	cityCode, ok := location.Spec.Topology[computev1alpha.CityCodeTopologyKey]
+	if !ok {
+		return nil
+	}
+
+	cluster, err := mgr.GetCluster(ctx, clusterName)
+	if err != nil {
+		logger.Error(err, "failed to get cluster")
+		return nil
+	}
+	clusterClient := cluster.GetClient()
+
+	workloads := sets.Set[types.NamespacedName]{}
+	for _, indexValue := range []string{cityCode, anyCityCode} {
+		listOpts := client.MatchingFields{
+			workloadCityCodesIndex: indexValue,
+		}
+
+		var workloadList computev1alpha.WorkloadList
+		if err := clusterClient.List(ctx, &workloadList, listOpts); err != nil {
+			logger.Error(err, "failed to list workloads")
+			return nil
+		}
+
+		for _, workload := range workloadList.Items {
+			workloads.Insert(client.ObjectKeyFromObject(&workload))
+		}
+	}
+
+	requests := make([]mcreconcile.Request, 0, len(workloads))
+	for workload := range workloads {
+		requests = append(requests, mcreconcile.Request{
+			Request: reconcile.Request{
+				NamespacedName: workload,
+			},
+			ClusterName: clusterName,
+		})
+	}
+
+	return requests
+}
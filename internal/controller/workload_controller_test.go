@@ -0,0 +1,314 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestReconcileGateway(t *testing.T) {
+	workload := getGatewayTestWorkload()
+
+	fakeClient := getGatewayTestClient(
+		getGatewayTestInstance(workload, "test-0", ptr.To("10.0.0.1"), metav1.ConditionTrue),
+		getGatewayTestInstance(workload, "test-1", ptr.To("10.0.0.2"), metav1.ConditionFalse),
+		// Instances without an address are not included as endpoints.
+		getGatewayTestInstance(workload, "test-2", nil, metav1.ConditionFalse),
+	)
+
+	reconciler := &WorkloadReconciler{}
+	ctx := context.Background()
+
+	_, err := reconciler.reconcileGateway(ctx, fakeClient, workload)
+	assert.NoError(t, err)
+
+	var gateway gatewayv1.Gateway
+	if assert.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "test-gateway"}, &gateway)) {
+		assert.Equal(t, string(workload.UID), gateway.Labels[computev1alpha.WorkloadUIDLabel])
+		assert.True(t, metav1.IsControlledBy(&gateway, workload))
+		assert.Equal(t, workload.Spec.Gateway.Template.Spec, gateway.Spec)
+	}
+
+	var service corev1.Service
+	if assert.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "test-http"}, &service)) {
+		assert.True(t, metav1.IsControlledBy(&service, workload))
+		assert.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+		assert.Empty(t, service.Spec.Selector)
+		if assert.Len(t, service.Spec.Ports, 1) {
+			assert.Equal(t, "http", service.Spec.Ports[0].Name)
+			assert.Equal(t, int32(8080), service.Spec.Ports[0].Port)
+			assert.Equal(t, corev1.ProtocolTCP, service.Spec.Ports[0].Protocol)
+		}
+	}
+
+	var endpointSlice discoveryv1.EndpointSlice
+	if assert.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "test-http"}, &endpointSlice)) {
+		assert.Equal(t, "test-http", endpointSlice.Labels[discoveryv1.LabelServiceName])
+		assert.Equal(t, discoveryv1.AddressTypeIPv4, endpointSlice.AddressType)
+		if assert.Len(t, endpointSlice.Ports, 1) {
+			assert.Equal(t, int32(8080), ptr.Deref(endpointSlice.Ports[0].Port, 0))
+		}
+		if assert.Len(t, endpointSlice.Endpoints, 2) {
+			assert.Equal(t, []string{"10.0.0.1"}, endpointSlice.Endpoints[0].Addresses)
+			assert.True(t, ptr.Deref(endpointSlice.Endpoints[0].Conditions.Ready, false))
+			assert.Equal(t, "test-0", endpointSlice.Endpoints[0].TargetRef.Name)
+			assert.Equal(t, []string{"10.0.0.2"}, endpointSlice.Endpoints[1].Addresses)
+			assert.False(t, ptr.Deref(endpointSlice.Endpoints[1].Conditions.Ready, true))
+		}
+	}
+
+	var tcpRoute gatewayv1alpha2.TCPRoute
+	if assert.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "test-gateway-web"}, &tcpRoute)) {
+		assert.Equal(t, string(workload.UID), tcpRoute.Labels[computev1alpha.WorkloadUIDLabel])
+		if assert.Len(t, tcpRoute.Spec.ParentRefs, 1) {
+			assert.Equal(t, gatewayv1.ObjectName("test-gateway"), tcpRoute.Spec.ParentRefs[0].Name)
+			assert.Equal(t, gatewayv1.SectionName("tcp"), ptr.Deref(tcpRoute.Spec.ParentRefs[0].SectionName, ""))
+		}
+		if assert.Len(t, tcpRoute.Spec.Rules, 1) && assert.Len(t, tcpRoute.Spec.Rules[0].BackendRefs, 1) {
+			assert.Equal(t, gatewayv1.BackendObjectReference{
+				Group: ptr.To(gatewayv1.Group("")),
+				Kind:  ptr.To(gatewayv1.Kind("Service")),
+				Name:  "test-http",
+				Port:  ptr.To(gatewayv1.PortNumber(8080)),
+			}, tcpRoute.Spec.Rules[0].BackendRefs[0].BackendObjectReference)
+		}
+	}
+}
+
+func TestReconcileGatewayPrunesRoutes(t *testing.T) {
+	workload := getGatewayTestWorkload()
+
+	staleRoute := &gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-gateway-stale",
+			Labels: map[string]string{
+				computev1alpha.WorkloadUIDLabel: string(workload.UID),
+			},
+		},
+	}
+
+	unrelatedRoute := &gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "unrelated",
+		},
+	}
+
+	fakeClient := getGatewayTestClient(staleRoute, unrelatedRoute)
+
+	reconciler := &WorkloadReconciler{}
+	ctx := context.Background()
+
+	_, err := reconciler.reconcileGateway(ctx, fakeClient, workload)
+	assert.NoError(t, err)
+
+	var tcpRoutes gatewayv1alpha2.TCPRouteList
+	assert.NoError(t, fakeClient.List(ctx, &tcpRoutes))
+
+	var routeNames []string
+	for _, tcpRoute := range tcpRoutes.Items {
+		routeNames = append(routeNames, tcpRoute.Name)
+	}
+	assert.ElementsMatch(t, []string{"test-gateway-web", "unrelated"}, routeNames)
+}
+
+func TestReconcileGatewayRemoved(t *testing.T) {
+	workload := getGatewayTestWorkload()
+	reconciler := &WorkloadReconciler{}
+	ctx := context.Background()
+
+	fakeClient := getGatewayTestClient(
+		getGatewayTestInstance(workload, "test-0", ptr.To("10.0.0.1"), metav1.ConditionTrue),
+	)
+
+	_, err := reconciler.reconcileGateway(ctx, fakeClient, workload)
+	assert.NoError(t, err)
+
+	workload.Spec.Gateway = nil
+
+	gatewayStatus, err := reconciler.reconcileGateway(ctx, fakeClient, workload)
+	assert.NoError(t, err)
+	assert.Nil(t, gatewayStatus)
+
+	objectKey := client.ObjectKey{Namespace: "default"}
+	for name, obj := range map[string]client.Object{
+		"test-gateway":     &gatewayv1.Gateway{},
+		"test-gateway-web": &gatewayv1alpha2.TCPRoute{},
+		"test-http":        &corev1.Service{},
+	} {
+		objectKey.Name = name
+		err := fakeClient.Get(ctx, objectKey, obj)
+		assert.True(t, apierrors.IsNotFound(err), "expected %T %q to be deleted, got %v", obj, name, err)
+	}
+
+	objectKey.Name = "test-http"
+	err = fakeClient.Get(ctx, objectKey, &discoveryv1.EndpointSlice{})
+	assert.True(t, apierrors.IsNotFound(err), "expected endpoint slice to be deleted, got %v", err)
+}
+
+func TestReconcileGatewayStatus(t *testing.T) {
+	workload := getGatewayTestWorkload()
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-gateway",
+		},
+		Status: gatewayv1.GatewayStatus{
+			Addresses: []gatewayv1.GatewayStatusAddress{
+				{
+					Type:  ptr.To(gatewayv1.IPAddressType),
+					Value: "203.0.113.10",
+				},
+			},
+			Conditions: []metav1.Condition{
+				{
+					Type:   string(gatewayv1.GatewayConditionProgrammed),
+					Status: metav1.ConditionTrue,
+					Reason: string(gatewayv1.GatewayReasonProgrammed),
+				},
+			},
+		},
+	}
+
+	fakeClient := getGatewayTestClient(gateway)
+
+	gatewayStatus, err := (&WorkloadReconciler{}).reconcileGateway(context.Background(), fakeClient, workload)
+	assert.NoError(t, err)
+	if assert.NotNil(t, gatewayStatus) {
+		assert.Equal(t, gateway.Status, gatewayStatus.GatewayStatus)
+	}
+}
+
+func getGatewayTestClient(objs ...client.Object) client.Client {
+	scheme := k8sruntime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(computev1alpha.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(gatewayv1alpha2.Install(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+}
+
+func getGatewayTestWorkload() *computev1alpha.Workload {
+	return &computev1alpha.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test",
+			UID:       "workload-uid",
+		},
+		Spec: computev1alpha.WorkloadSpec{
+			Template: computev1alpha.InstanceTemplateSpec{
+				Spec: computev1alpha.InstanceSpec{
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Sandbox: &computev1alpha.SandboxRuntime{
+							Containers: []computev1alpha.SandboxContainer{
+								{
+									Name: "container1",
+									Ports: []computev1alpha.NamedPort{
+										{
+											Name: "http",
+											Port: 8080,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Gateway: &computev1alpha.WorkloadGateway{
+				Template: computev1alpha.WorkloadGatewayTemplate{
+					Spec: gatewayv1.GatewaySpec{
+						GatewayClassName: "test",
+						Listeners: []gatewayv1.Listener{
+							{
+								Name:     "tcp",
+								Port:     80,
+								Protocol: gatewayv1.TCPProtocolType,
+							},
+						},
+					},
+				},
+				TCPRoutes: []computev1alpha.WorkloadGatewayTCPRouteSpec{
+					{
+						Name: "web",
+						CommonRouteSpec: gatewayv1.CommonRouteSpec{
+							ParentRefs: []gatewayv1.ParentReference{
+								{
+									Name:        computev1alpha.WorkloadGatewayParentRefName,
+									SectionName: ptr.To(gatewayv1.SectionName("tcp")),
+								},
+							},
+						},
+						Rules: []computev1alpha.WorkloadGatewayTCPRouteRule{
+							{
+								BackendRefs: []gatewayv1.BackendRef{
+									{
+										BackendObjectReference: gatewayv1.BackendObjectReference{
+											Group: ptr.To(gatewayv1.Group(computev1alpha.GroupVersion.Group)),
+											Kind:  ptr.To(gatewayv1.Kind(computev1alpha.NamedPortKind)),
+											Name:  "http",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getGatewayTestInstance(
+	workload *computev1alpha.Workload,
+	name string,
+	networkIP *string,
+	ready metav1.ConditionStatus,
+) *computev1alpha.Instance {
+	return &computev1alpha.Instance{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: workload.Namespace,
+			Name:      name,
+			Labels: map[string]string{
+				computev1alpha.WorkloadUIDLabel: string(workload.UID),
+			},
+		},
+		Spec: *workload.Spec.Template.Spec.DeepCopy(),
+		Status: computev1alpha.InstanceStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   computev1alpha.InstanceReady,
+					Status: ready,
+				},
+			},
+			NetworkInterfaces: []computev1alpha.InstanceNetworkInterfaceStatus{
+				{
+					Assignments: computev1alpha.InstanceNetworkInterfaceAssignmentsStatus{
+						NetworkIP: networkIP,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
+	mccontext "sigs.k8s.io/multicluster-runtime/pkg/context"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+	mcreconcile "sigs.k8s.io/multicluster-runtime/pkg/reconcile"
+
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/controller/autoscaling"
+	"go.datum.net/workload-operator/internal/controller/instancecontrol"
+)
+
+// WorkloadDeploymentAutoscaler calculates the desired number of instances for
+// a WorkloadDeployment based on the metrics defined in its scale settings.
+type WorkloadDeploymentAutoscaler struct {
+	mgr mcmanager.Manager
+
+	// MetricsSource provides instance metrics to the autoscaler.
+	MetricsSource autoscaling.MetricsSource
+
+	// SyncPeriod is the interval at which the desired number of instances is
+	// recalculated.
+	SyncPeriod time.Duration
+
+	calculator *autoscaling.ReplicaCalculator
+	normalizer *autoscaling.BehaviorNormalizer
+}
+
+func (r *WorkloadDeploymentAutoscaler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cl, err := r.mgr.GetCluster(ctx, req.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Scaling history is tracked per deployment in each cluster.
+	normalizerKey := fmt.Sprintf("%s/%s", req.ClusterName, req.NamespacedName)
+
+	ctx = mccontext.WithCluster(ctx, req.ClusterName)
+	var deployment computev1alpha.WorkloadDeployment
+	if err := cl.GetClient().Get(ctx, req.NamespacedName, &deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.normalizer.Forget(normalizerKey)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !deployment.DeletionTimestamp.IsZero() {
+		r.normalizer.Forget(normalizerKey)
+		return ctrl.Result{}, nil
+	}
+
+	scaleSettings := deployment.Spec.ScaleSettings
+	if scaleSettings.MaxReplicas == nil || len(scaleSettings.Metrics) == 0 {
+		r.normalizer.Forget(normalizerKey)
+
+		// The deployment is no longer autoscaled, clear out any previous state.
+		if deployment.Status.Autoscaling != nil ||
+			apimeta.FindStatusCondition(deployment.Status.Conditions, computev1alpha.WorkloadDeploymentScalingActive) != nil {
+			deployment.Status.Autoscaling = nil
+			apimeta.RemoveStatusCondition(&deployment.Status.Conditions, computev1alpha.WorkloadDeploymentScalingActive)
+			if err := cl.GetClient().Status().Update(ctx, &deployment); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to update deployment status: %w", err)
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if instancecontrol.Draining(&deployment) {
+		// Retain the previous autoscaling state while instances are drained from
+		// the deployment's location, so that the deployment is scaled back up
+		// once it has been rescheduled.
+		return ctrl.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	if instancecontrol.DesiredPowerState(&deployment) == computev1alpha.StoppedInstancePowerState {
+		// Stopped instances do not report metrics, so the previous autoscaling
+		// state is retained until the instances are started again.
+		return ctrl.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	logger.Info("autoscaling deployment")
+	defer logger.Info("autoscaling complete")
+
+	originalStatus := deployment.Status.DeepCopy()
+
+	currentReplicas := instancecontrol.DesiredReplicas(&deployment)
+	desiredReplicas, currentMetrics, err := r.calculator.GetDesiredReplicas(ctx, &deployment, currentReplicas)
+	if err != nil {
+		logger.Error(err, "failed calculating desired replicas")
+
+		// Retain the previously calculated desired replicas, so that a temporary
+		// loss of metrics does not result in the deployment being scaled down.
+		apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+			Type:               computev1alpha.WorkloadDeploymentScalingActive,
+			Status:             metav1.ConditionFalse,
+			Reason:             "FailedGetMetrics",
+			ObservedGeneration: deployment.Generation,
+			Message:            err.Error(),
+		})
+	} else {
+		// Apply stabilization windows and scale rate limits.
+		desiredReplicas = r.normalizer.Normalize(normalizerKey, scaleSettings, currentReplicas, desiredReplicas)
+
+		autoscalingStatus := deployment.Status.Autoscaling
+		if autoscalingStatus == nil {
+			autoscalingStatus = &computev1alpha.WorkloadDeploymentAutoscalingStatus{}
+		}
+
+		if autoscalingStatus.DesiredReplicas != desiredReplicas {
+			logger.Info("desired replicas changed", "current_replicas", currentReplicas, "desired_replicas", desiredReplicas)
+			autoscalingStatus.DesiredReplicas = desiredReplicas
+			autoscalingStatus.LastScaleTime = &metav1.Time{Time: time.Now()}
+		}
+		autoscalingStatus.CurrentMetrics = currentMetrics
+		deployment.Status.Autoscaling = autoscalingStatus
+
+		apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+			Type:               computev1alpha.WorkloadDeploymentScalingActive,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ValidMetricFound",
+			ObservedGeneration: deployment.Generation,
+			Message:            "The autoscaler was able to calculate the desired number of instances.",
+		})
+	}
+
+	if !equality.Semantic.DeepEqual(originalStatus, &deployment.Status) {
+		if err := cl.GetClient().Status().Update(ctx, &deployment); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update deployment status: %w", err)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: r.SyncPeriod}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WorkloadDeploymentAutoscaler) SetupWithManager(mgr mcmanager.Manager) error {
+	r.mgr = mgr
+	r.calculator = autoscaling.NewReplicaCalculator(r.MetricsSource, autoscaling.DefaultTolerance)
+	r.normalizer = autoscaling.NewBehaviorNormalizer()
+	return mcbuilder.ControllerManagedBy(mgr).
+		For(&computev1alpha.WorkloadDeployment{},
+			mcbuilder.WithEngageWithLocalCluster(false),
+			// Status updates made by the autoscaler should not trigger additional
+			// reconciles, metrics are polled on SyncPeriod.
+			mcbuilder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Named("workload-deployment-autoscaler").
+		Complete(r)
+}
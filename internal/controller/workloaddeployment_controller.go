@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -42,6 +44,7 @@ type WorkloadDeploymentReconciler struct {
 // +kubebuilder:rbac:groups=compute.datumapis.com,resources=workloaddeployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=compute.datumapis.com,resources=workloaddeployments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=compute.datumapis.com,resources=workloaddeployments/finalizers,verbs=update
+// +kubebuilder:rbac:groups=compute.datumapis.com,resources=instancerevisions,verbs=get;list;watch;create;update;patch;delete
 
 func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
@@ -86,6 +89,11 @@ func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreco
 	logger.Info("reconciling deployment")
 	defer logger.Info("reconcile complete")
 
+	currentRevision, err := r.reconcileRevisions(ctx, cl.GetClient(), &deployment)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed reconciling instance revisions: %w", err)
+	}
+
 	if deployment.Status.Location == nil {
 		return ctrl.Result{}, nil
 	}
@@ -100,9 +108,27 @@ func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreco
 		return ctrl.Result{}, fmt.Errorf("failed listing instances: %w", err)
 	}
 
+	// Unhealthy instances are deleted before collecting instance control
+	// actions, so that the deployment's strategy waits for their removal and
+	// creates them again according to the instance management policy.
+	healing := instancecontrol.PlanHealing(&deployment, instances.Items, time.Now())
+	if healing != nil {
+		for _, action := range healing.Actions {
+			logger.Info("repairing unhealthy instance", "instance", action.Object.GetName())
+
+			if err := action.Execute(ctx, cl.GetClient()); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed deleting unhealthy instance: %w", err)
+			}
+
+			if action.Object.GetDeletionTimestamp().IsZero() {
+				action.Object.SetDeletionTimestamp(ptr.To(metav1.Now()))
+			}
+		}
+	}
+
 	instanceControl := instancecontrolstateful.New()
 
-	actions, err := instanceControl.GetActions(ctx, cl.GetScheme(), &deployment, instances.Items)
+	actions, err := instanceControl.GetActions(ctx, cl.GetScheme(), &deployment, currentRevision, instances.Items)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed getting instance control actions: %w", err)
 	}
@@ -134,12 +160,14 @@ func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreco
 
 	replicas := len(instances.Items)
 	currentReplicas := 0
-	desiredReplicas := deployment.Spec.ScaleSettings.MinReplicas
+	desiredReplicas := instancecontrol.DesiredReplicas(&deployment)
 	if dt := deployment.DeletionTimestamp; !dt.IsZero() {
 		desiredReplicas = 0
 	}
 
 	readyReplicas := 0
+	stoppedReplicas := 0
+	restartPending := false
 	for _, instance := range instances.Items {
 		if networkReady && len(instance.Spec.Controller.SchedulingGates) > 0 {
 			newGates := slices.DeleteFunc(instance.Spec.Controller.SchedulingGates, func(gate computev1alpha.SchedulingGate) bool {
@@ -165,13 +193,46 @@ func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreco
 		if apimeta.IsStatusConditionTrue(instance.Status.Conditions, computev1alpha.InstanceReady) {
 			readyReplicas++
 		}
+
+		if instancecontrol.PowerState(instance.Spec) == computev1alpha.StoppedInstancePowerState {
+			stoppedReplicas++
+		}
+
+		if instancecontrol.RestartPending(&deployment, &instance) || instancecontrol.Restarting(&instance) {
+			restartPending = true
+		}
 	}
 
 	patchResult, err := controllerutil.CreateOrPatch(ctx, cl.GetClient(), &deployment, func() error {
+		deployment.Status.ObservedGeneration = deployment.Generation
 		deployment.Status.Replicas = int32(replicas)
 		deployment.Status.CurrentReplicas = int32(currentReplicas)
 		deployment.Status.DesiredReplicas = desiredReplicas
 		deployment.Status.ReadyReplicas = int32(readyReplicas)
+		deployment.Status.StoppedReplicas = int32(stoppedReplicas)
+
+		if request := deployment.Spec.Restart; request != nil {
+			lastRestart := deployment.Status.LastRestart
+			if lastRestart == nil || !lastRestart.RequestedAt.Equal(&request.RequestedAt) {
+				lastRestart = &computev1alpha.InstanceRestartStatus{
+					RequestedAt: request.RequestedAt,
+				}
+			}
+
+			// The restart is complete once every instance has been restarted, and
+			// all desired instances are ready again.
+			if lastRestart.CompletedAt == nil && !restartPending &&
+				replicas == int(desiredReplicas) && readyReplicas+stoppedReplicas == replicas {
+				lastRestart.CompletedAt = ptr.To(metav1.Now())
+			}
+
+			deployment.Status.LastRestart = lastRestart
+		}
+
+		deployment.Status.Healing = nil
+		if healing != nil {
+			deployment.Status.Healing = healing.Status
+		}
 
 		if readyReplicas > 0 {
 			apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
@@ -180,6 +241,13 @@ func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreco
 				Reason:  "StableInstanceFound",
 				Message: fmt.Sprintf("%d/%d instances are ready", readyReplicas, replicas),
 			})
+		} else if replicas > 0 && stoppedReplicas == replicas {
+			apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+				Type:    computev1alpha.WorkloadDeploymentAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "InstancesStopped",
+				Message: "All instances have been stopped",
+			})
 		} else if !networkReady {
 			apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
 				Type:    computev1alpha.WorkloadDeploymentAvailable,
@@ -205,9 +273,116 @@ func (r *WorkloadDeploymentReconciler) Reconcile(ctx context.Context, req mcreco
 
 	logger.Info("deployment status processed", "operation_result", patchResult)
 
+	if healing != nil && healing.RequeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: healing.RequeueAfter}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// reconcileRevisions ensures an InstanceRevision exists for the deployment's
+// instance template, and prunes revisions which exceed the deployment's
+// revision history limit. The deployment's current revision is returned.
+//
+// While the deployment is paused, the current revision is not advanced to the
+// revision of a new instance template.
+func (r *WorkloadDeploymentReconciler) reconcileRevisions(
+	ctx context.Context,
+	c client.Client,
+	deployment *computev1alpha.WorkloadDeployment,
+) (*computev1alpha.InstanceRevision, error) {
+	logger := log.FromContext(ctx)
+
+	var revisions computev1alpha.InstanceRevisionList
+	listOpts := []client.ListOption{
+		client.InNamespace(deployment.Namespace),
+		client.MatchingLabels{
+			computev1alpha.WorkloadDeploymentUIDLabel: string(deployment.UID),
+		},
+	}
+
+	if err := c.List(ctx, &revisions, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed listing instance revisions: %w", err)
+	}
+
+	templateHash := instancecontrol.ComputeHash(deployment.Spec.Template)
+	templateRevision := instancecontrol.FindRevision(revisions.Items, templateHash)
+	revisionNumber := instancecontrol.DeploymentRevision(deployment, revisions.Items, templateRevision)
+
+	if templateRevision == nil {
+		templateRevision = &computev1alpha.InstanceRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: deployment.Namespace,
+				Name:      fmt.Sprintf("%s-%s", deployment.Name, templateHash),
+				Labels: map[string]string{
+					computev1alpha.WorkloadUIDLabel:           string(deployment.Spec.WorkloadRef.UID),
+					computev1alpha.WorkloadDeploymentUIDLabel: string(deployment.UID),
+					computev1alpha.InstanceTemplateHashLabel:  templateHash,
+				},
+			},
+			Template: deployment.Spec.Template,
+			Revision: revisionNumber,
+		}
+
+		if deployment.Labels[computev1alpha.TemplateOverriddenLabel] == "true" {
+			templateRevision.Labels[computev1alpha.TemplateOverriddenLabel] = "true"
+		}
+
+		if err := controllerutil.SetControllerReference(deployment, templateRevision, c.Scheme()); err != nil {
+			return nil, fmt.Errorf("failed to set controller on instance revision: %w", err)
+		}
+
+		if err := c.Create(ctx, templateRevision); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				return nil, fmt.Errorf("failed creating instance revision: %w", err)
+			}
+
+			// The cache has not yet observed the revision.
+			if err := c.Get(ctx, client.ObjectKeyFromObject(templateRevision), templateRevision); err != nil {
+				return nil, fmt.Errorf("failed fetching instance revision: %w", err)
+			}
+		} else {
+			logger.Info("created instance revision", "revision", templateRevision.Name, "revision_number", templateRevision.Revision)
+		}
+
+		revisions.Items = append(revisions.Items, *templateRevision)
+	} else if templateRevision.Revision != revisionNumber {
+		// A previous template has been applied again, such as after a rollback,
+		// so the revision becomes the latest revision.
+		templateRevision.Revision = revisionNumber
+		if err := c.Update(ctx, templateRevision); err != nil {
+			return nil, fmt.Errorf("failed updating instance revision: %w", err)
+		}
+		logger.Info("updated instance revision", "revision", templateRevision.Name, "revision_number", templateRevision.Revision)
+	}
+
+	currentRevision := templateRevision
+	if deployment.Spec.Paused && deployment.Status.CurrentRevision != "" {
+		if i := slices.IndexFunc(revisions.Items, func(revision computev1alpha.InstanceRevision) bool {
+			return revision.Name == deployment.Status.CurrentRevision
+		}); i != -1 {
+			currentRevision = &revisions.Items[i]
+		}
+	}
+
+	revisionHistoryLimit := ptr.Deref(deployment.Spec.RevisionHistoryLimit, instancecontrol.DefaultRevisionHistoryLimit)
+	for _, revision := range instancecontrol.RevisionsToPrune(revisions.Items, revisionHistoryLimit, currentRevision.Name, templateRevision.Name) {
+		if err := c.Delete(ctx, &revision); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed deleting instance revision: %w", err)
+		}
+		logger.Info("pruned instance revision", "revision", revision.Name, "revision_number", revision.Revision)
+	}
+
+	if deployment.Status.CurrentRevision != currentRevision.Name {
+		deployment.Status.CurrentRevision = currentRevision.Name
+		if err := c.Status().Update(ctx, deployment); err != nil {
+			return nil, fmt.Errorf("failed updating deployment status: %w", err)
+		}
+	}
+
+	return currentRevision, nil
+}
+
 func (r *WorkloadDeploymentReconciler) reconcileNetworks(
 	ctx context.Context,
 	c client.Client,
@@ -10,10 +10,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	mcbuilder "sigs.k8s.io/multicluster-runtime/pkg/builder"
 	mccontext "sigs.k8s.io/multicluster-runtime/pkg/context"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
@@ -21,13 +23,23 @@ import (
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/config"
+	"go.datum.net/workload-operator/internal/controller/instancecontrol"
+	"go.datum.net/workload-operator/internal/controller/scheduling"
 )
 
 // WorkloadDeploymentScheduler schedules a WorkloadDeployment
 type WorkloadDeploymentScheduler struct {
-	mgr mcmanager.Manager
+	mgr       mcmanager.Manager
+	framework *scheduling.Framework
+
+	// Config determines the plugins used to select a location for deployments.
+	Config config.SchedulerConfig
 }
 
+// +kubebuilder:rbac:groups=compute.datumapis.com,resources=locationcapacities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=compute.datumapis.com,resources=instancetypes,verbs=get;list;watch
+
 func (r *WorkloadDeploymentScheduler) Reconcile(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
@@ -49,15 +61,23 @@ func (r *WorkloadDeploymentScheduler) Reconcile(ctx context.Context, req mcrecon
 		return ctrl.Result{}, nil
 	}
 
+	if deployment.Status.Location != nil {
+		unbound, err := r.reconcileLocationBinding(ctx, cl.GetClient(), &deployment)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed reconciling location binding: %w", err)
+		}
+
+		if !unbound {
+			if !r.framework.PluginEnabled(scheduling.CapacityPluginName) {
+				return ctrl.Result{}, nil
+			}
+			return r.reconcileBoundCapacity(ctx, cl.GetClient(), &deployment)
+		}
+	}
+
 	logger.Info("scheduling deployment")
 	defer logger.Info("scheduling complete")
 
-	// TODO(jreese) improve!
-	// The first iteration of this scheduler will be very simple and only look for
-	// the first available location that is viable for the deployment. In the
-	// future, we could see a more advanced system similar to the Kubernetes
-	// scheduler itself.
-
 	// Step 1: Get Locations
 	var locations networkingv1alpha.LocationList
 	if err := cl.GetClient().List(ctx, &locations); err != nil {
@@ -87,17 +107,23 @@ func (r *WorkloadDeploymentScheduler) Reconcile(ctx context.Context, req mcrecon
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 	}
 
-	// TODO(jreese) define standard Topology keys somewhere
+	// Step 2: Filter and score locations
+	result, err := r.framework.Schedule(ctx, cl.GetClient(), &deployment, locations.Items)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed scheduling deployment: %w", err)
+	}
 
-	var selectedLocation *networkingv1alpha.Location
-	for _, location := range locations.Items {
-		cityCode, ok := location.Spec.Topology["topology.datum.net/city-code"]
-		if ok && cityCode == deployment.Spec.CityCode {
-			selectedLocation = &location
-			break
+	// Record the result of each plugin so that scheduling decisions can be
+	// explained.
+	conditionsChanged := false
+	for _, condition := range result.Conditions {
+		condition.ObservedGeneration = deployment.Generation
+		if apimeta.SetStatusCondition(&deployment.Status.Conditions, condition) {
+			conditionsChanged = true
 		}
 	}
 
+	selectedLocation := result.SelectedLocation
 	if selectedLocation == nil {
 		changed := apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
 			Type:               "Available",
@@ -106,11 +132,15 @@ func (r *WorkloadDeploymentScheduler) Reconcile(ctx context.Context, req mcrecon
 			ObservedGeneration: deployment.Generation,
 			Message:            "No locations are candidates for this deployment.",
 		})
-		if changed {
+		if changed || conditionsChanged {
 			if err := cl.GetClient().Status().Update(ctx, &deployment); err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to update deployment status: %w", err)
 			}
 		}
+
+		// Locations may become candidates as capacity is released by other
+		// deployments.
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 	} else {
 		deployment.Status.Location = &networkingv1alpha.LocationReference{
 			Name:      selectedLocation.Name,
@@ -137,17 +167,247 @@ func (r *WorkloadDeploymentScheduler) Reconcile(ctx context.Context, req mcrecon
 	return ctrl.Result{}, nil
 }
 
+// reconcileLocationBinding determines whether the deployment may remain in its
+// location. When the location has been removed, marked unschedulable, or no
+// longer matches the deployment, instances are drained from the location before
+// the deployment is unbound so that it may be rescheduled.
+//
+// Returns true if the deployment has been unbound from its location.
+func (r *WorkloadDeploymentScheduler) reconcileLocationBinding(
+	ctx context.Context,
+	c client.Client,
+	deployment *computev1alpha.WorkloadDeployment,
+) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	locationName := types.NamespacedName{
+		Namespace: deployment.Status.Location.Namespace,
+		Name:      deployment.Status.Location.Name,
+	}.String()
+
+	reason, message, err := getLocationEvictionReason(ctx, c, deployment)
+	if err != nil {
+		return false, err
+	}
+
+	if reason == "" {
+		// The location is viable. If a drain was started, the location has since
+		// recovered, so stop draining and allow instances to be recreated.
+		if instancecontrol.Draining(deployment) {
+			logger.Info("location is viable again, cancelling drain", "location", locationName)
+			apimeta.RemoveStatusCondition(&deployment.Status.Conditions, computev1alpha.WorkloadDeploymentRescheduling)
+			if err := c.Status().Update(ctx, deployment); err != nil {
+				return false, fmt.Errorf("failed to update deployment status: %w", err)
+			}
+		}
+		return false, nil
+	}
+
+	// Drain instances from the location. The deployment controller removes all
+	// instances while the Rescheduling condition is true, following the
+	// deployment's instance management policy.
+	changed := apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+		Type:               computev1alpha.WorkloadDeploymentRescheduling,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		ObservedGeneration: deployment.Generation,
+		Message:            fmt.Sprintf("Draining instances from location %s: %s", locationName, message),
+	})
+	if changed {
+		logger.Info("draining deployment from location", "location", locationName, "reason", reason)
+		if err := c.Status().Update(ctx, deployment); err != nil {
+			return false, fmt.Errorf("failed to update deployment status: %w", err)
+		}
+		return false, nil
+	}
+
+	var instances computev1alpha.InstanceList
+	if err := c.List(ctx, &instances,
+		client.InNamespace(deployment.Namespace),
+		client.MatchingLabels{
+			computev1alpha.WorkloadDeploymentUIDLabel: string(deployment.UID),
+		},
+	); err != nil {
+		return false, fmt.Errorf("failed listing instances: %w", err)
+	}
+
+	if len(instances.Items) > 0 {
+		logger.Info("waiting for instances to be drained", "location", locationName, "instances", len(instances.Items))
+		return false, nil
+	}
+
+	// Network bindings are specific to a location, and must be removed so that
+	// they can be recreated in the new location.
+	var networkBindings networkingv1alpha.NetworkBindingList
+	if err := c.List(ctx, &networkBindings, client.InNamespace(deployment.Namespace)); err != nil {
+		return false, fmt.Errorf("failed listing network bindings: %w", err)
+	}
+
+	networkBindingsRemoved := true
+	for _, networkBinding := range networkBindings.Items {
+		if !metav1.IsControlledBy(&networkBinding, deployment) {
+			continue
+		}
+
+		networkBindingsRemoved = false
+		if networkBinding.DeletionTimestamp.IsZero() {
+			if err := c.Delete(ctx, &networkBinding); client.IgnoreNotFound(err) != nil {
+				return false, fmt.Errorf("failed deleting network binding: %w", err)
+			}
+		}
+	}
+
+	if !networkBindingsRemoved {
+		logger.Info("waiting for network bindings to be removed", "location", locationName)
+		return false, nil
+	}
+
+	logger.Info("deployment drained from location", "location", locationName)
+
+	deployment.Status.Location = nil
+	apimeta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+		Type:               computev1alpha.WorkloadDeploymentRescheduling,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		ObservedGeneration: deployment.Generation,
+		Message:            fmt.Sprintf("Deployment was moved from location %s: %s", locationName, message),
+	})
+
+	if err := c.Status().Update(ctx, deployment); err != nil {
+		return false, fmt.Errorf("failed to update deployment status: %w", err)
+	}
+
+	return true, nil
+}
+
+// reconcileBoundCapacity evaluates whether the location a deployment has been
+// bound to still has capacity for the deployment's instances, as the
+// deployment may have scaled or been assigned more replicas since it was
+// scheduled. The result is recorded in the Capacity plugin's condition. The
+// deployment is not moved out of its location.
+func (r *WorkloadDeploymentScheduler) reconcileBoundCapacity(
+	ctx context.Context,
+	c client.Client,
+	deployment *computev1alpha.WorkloadDeployment,
+) (ctrl.Result, error) {
+	condition, err := getBoundCapacityCondition(ctx, c, deployment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	condition.ObservedGeneration = deployment.Generation
+	if apimeta.SetStatusCondition(&deployment.Status.Conditions, condition) {
+		if err := c.Status().Update(ctx, deployment); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update deployment status: %w", err)
+		}
+	}
+
+	if condition.Status == metav1.ConditionFalse {
+		// Capacity may be released by other deployments in the location.
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getBoundCapacityCondition returns the Capacity plugin's condition for the
+// location the deployment has been bound to.
+func getBoundCapacityCondition(
+	ctx context.Context,
+	c client.Client,
+	deployment *computev1alpha.WorkloadDeployment,
+) (metav1.Condition, error) {
+	location := networkingv1alpha.Location{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: deployment.Status.Location.Namespace,
+			Name:      deployment.Status.Location.Name,
+		},
+	}
+
+	capacity := scheduling.NewCapacity(c).(scheduling.FilterPlugin)
+	status, err := capacity.Filter(ctx, deployment, &location)
+	if err != nil {
+		return metav1.Condition{}, fmt.Errorf("failed evaluating location capacity: %w", err)
+	}
+
+	condition := metav1.Condition{
+		Type:    scheduling.PluginConditionType(scheduling.CapacityPluginName),
+		Status:  metav1.ConditionTrue,
+		Reason:  "CapacityAvailable",
+		Message: fmt.Sprintf("location %s has capacity for the deployment's instances", location.Name),
+	}
+
+	if status != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = status.Reason
+		condition.Message = fmt.Sprintf("location %s: %s", location.Name, status.Message)
+	}
+
+	return condition, nil
+}
+
+// getLocationEvictionReason returns a reason and message explaining why the
+// deployment may no longer remain in its location. An empty reason is returned
+// if the location is still viable.
+func getLocationEvictionReason(
+	ctx context.Context,
+	c client.Client,
+	deployment *computev1alpha.WorkloadDeployment,
+) (string, string, error) {
+	var location networkingv1alpha.Location
+	locationObjectKey := client.ObjectKey{
+		Namespace: deployment.Status.Location.Namespace,
+		Name:      deployment.Status.Location.Name,
+	}
+	if err := c.Get(ctx, locationObjectKey, &location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "LocationDeleted", "location no longer exists", nil
+		}
+		return "", "", fmt.Errorf("failed fetching location: %w", err)
+	}
+
+	if !location.DeletionTimestamp.IsZero() {
+		return "LocationDeleted", "location is being deleted", nil
+	}
+
+	if scheduling.LocationUnschedulable(&location) {
+		return "LocationUnschedulable", "location has been marked unschedulable", nil
+	}
+
+	selector, err := scheduling.LocationSelectorAsSelector(deployment.Spec.LocationSelector)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !scheduling.LocationMatches(location, deployment.Spec.CityCode, selector) {
+		return "LocationMismatch", "location no longer matches the deployment's city code or location selector", nil
+	}
+
+	return "", "", nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *WorkloadDeploymentScheduler) SetupWithManager(mgr mcmanager.Manager) error {
 	r.mgr = mgr
+
+	framework, err := scheduling.NewFramework(r.Config)
+	if err != nil {
+		return fmt.Errorf("failed creating scheduling framework: %w", err)
+	}
+	r.framework = framework
+
 	return mcbuilder.ControllerManagedBy(mgr).
-		For(&computev1alpha.WorkloadDeployment{}, mcbuilder.WithPredicates(
-			predicate.NewPredicateFuncs(func(object client.Object) bool {
-				// Don't process deployments that have been scheduled
-				o := object.(*computev1alpha.WorkloadDeployment)
-				return o.Status.Location == nil
-			}),
-		)).
+		For(&computev1alpha.WorkloadDeployment{}).
+		Owns(&computev1alpha.Instance{}).
+		Owns(&networkingv1alpha.NetworkBinding{}).
+		Watches(&networkingv1alpha.Location{}, func(clusterName string, cl cluster.Cluster) handler.TypedEventHandler[client.Object, mcreconcile.Request] {
+			return handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []mcreconcile.Request {
+				return enqueueWorkloadDeploymentByLocation(ctx, mgr, clusterName, networkingv1alpha.LocationReference{
+					Namespace: o.GetNamespace(),
+					Name:      o.GetName(),
+				})
+			})
+		}).
 		Named("workload-deployment-scheduler").
 		Complete(r)
 }
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestGetLocationEvictionReason(t *testing.T) {
+	unschedulable := getTestLocation("dfw", "us-central")
+	unschedulable.Annotations = map[string]string{
+		computev1alpha.LocationUnschedulableAnnotation: "true",
+	}
+
+	tests := []struct {
+		name             string
+		location         *networkingv1alpha.Location
+		cityCode         string
+		locationSelector *metav1.LabelSelector
+		expectedReason   string
+	}{
+		{
+			name:     "viable location",
+			location: ptr.To(getTestLocation("dfw", "us-central")),
+			cityCode: "dfw",
+		},
+		{
+			name:           "deleted location",
+			cityCode:       "dfw",
+			expectedReason: "LocationDeleted",
+		},
+		{
+			name:           "unschedulable location",
+			location:       &unschedulable,
+			cityCode:       "dfw",
+			expectedReason: "LocationUnschedulable",
+		},
+		{
+			name:           "city code changed",
+			location:       ptr.To(getTestLocation("dfw", "us-central")),
+			cityCode:       "lhr",
+			expectedReason: "LocationMismatch",
+		},
+		{
+			name:     "location selector no longer matches",
+			location: ptr.To(getTestLocation("dfw", "us-central")),
+			cityCode: "dfw",
+			locationSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"topology.datum.net/region": "us-east",
+				},
+			},
+			expectedReason: "LocationMismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := k8sruntime.NewScheme()
+			utilruntime.Must(networkingv1alpha.AddToScheme(scheme))
+
+			var objs []client.Object
+			if tt.location != nil {
+				objs = append(objs, tt.location)
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objs...).
+				Build()
+
+			deployment := &computev1alpha.WorkloadDeployment{
+				Spec: computev1alpha.WorkloadDeploymentSpec{
+					CityCode:         tt.cityCode,
+					LocationSelector: tt.locationSelector,
+				},
+				Status: computev1alpha.WorkloadDeploymentStatus{
+					Location: &networkingv1alpha.LocationReference{
+						Name: "dfw",
+					},
+				},
+			}
+
+			reason, _, err := getLocationEvictionReason(context.Background(), fakeClient, deployment)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReason, reason)
+		})
+	}
+}
+
+func TestGetBoundCapacityCondition(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	utilruntime.Must(computev1alpha.AddToScheme(scheme))
+
+	locationRef := &networkingv1alpha.LocationReference{
+		Namespace: "default",
+		Name:      "dfw",
+	}
+
+	newDeployment := func(name string, minReplicas int32, maxReplicas *int32) *computev1alpha.WorkloadDeployment {
+		return &computev1alpha.WorkloadDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				UID:       types.UID(name),
+			},
+			Spec: computev1alpha.WorkloadDeploymentSpec{
+				Template: computev1alpha.InstanceTemplateSpec{
+					Spec: computev1alpha.InstanceSpec{
+						Runtime: computev1alpha.InstanceRuntimeSpec{
+							Resources: computev1alpha.InstanceRuntimeResources{
+								InstanceType: "datumcloud/d1-standard-2",
+							},
+						},
+					},
+				},
+				ScaleSettings: computev1alpha.HorizontalScaleSettings{
+					MinReplicas: minReplicas,
+					MaxReplicas: maxReplicas,
+				},
+			},
+			Status: computev1alpha.WorkloadDeploymentStatus{
+				Location: locationRef,
+			},
+		}
+	}
+
+	locationCapacity := &computev1alpha.LocationCapacity{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "dfw",
+		},
+		Spec: computev1alpha.LocationCapacitySpec{
+			LocationRef: *locationRef,
+			Instances: []computev1alpha.InstanceTypeCapacity{
+				{
+					InstanceType: "datumcloud/d1-standard-2",
+					Limit:        4,
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(locationCapacity, newDeployment("other", 2, nil)).
+		Build()
+
+	// Deployments which may be autoscaled reserve their maximum replicas.
+	condition, err := getBoundCapacityCondition(context.Background(), fakeClient, newDeployment("test", 1, ptr.To[int32](3)))
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, "InsufficientCapacity", condition.Reason)
+	assert.Contains(t, condition.Message, "3 datumcloud/d1-standard-2 instances requested, 2 of 4 available")
+
+	condition, err = getBoundCapacityCondition(context.Background(), fakeClient, newDeployment("test", 1, ptr.To[int32](2)))
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+}
@@ -2,6 +2,8 @@ package validation
 
 import (
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 
 	"golang.org/x/crypto/ssh"
@@ -9,9 +11,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
@@ -71,12 +75,21 @@ func validateInstanceSpec(
 	volumes, volumeErrs := validateVolumes(spec, fieldPath)
 	allErrs = append(allErrs, volumeErrs...)
 
-	allErrs = append(allErrs, validateInstanceRuntimeSpec(spec.Runtime, volumes, fieldPath.Child("runtime"))...)
+	allErrs = append(allErrs, validateInstanceRuntimeSpec(spec.Runtime, volumes, fieldPath.Child("runtime"), opts)...)
 	allErrs = append(allErrs, validateInstanceNetworkInterfaces(spec.NetworkInterfaces, fieldPath.Child("networkInterfaces"), opts)...)
 
+	if len(spec.PowerState) > 0 && !supportedInstancePowerStates.Has(spec.PowerState) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("powerState"), spec.PowerState, sets.List(supportedInstancePowerStates)))
+	}
+
 	return allErrs
 }
 
+var supportedInstancePowerStates = sets.New(
+	computev1alpha.RunningInstancePowerState,
+	computev1alpha.StoppedInstancePowerState,
+)
+
 func validateInstanceNetworkInterfaces(
 	networkInterfaces []computev1alpha.InstanceNetworkInterface,
 	fieldPath *field.Path,
@@ -139,7 +152,7 @@ func validateVolumes(spec computev1alpha.InstanceSpec, fieldPath *field.Path) (m
 	volumeAttachments := sets.Set[string]{}
 
 	if spec.Runtime.Sandbox != nil {
-		for _, c := range spec.Runtime.Sandbox.Containers {
+		for _, c := range slices.Concat(spec.Runtime.Sandbox.Containers, spec.Runtime.Sandbox.InitContainers) {
 			for _, a := range c.VolumeAttachments {
 				volumeAttachments.Insert(a.Name)
 			}
@@ -418,10 +431,23 @@ func validateDiskPopulator(populator *computev1alpha.DiskPopulator, fieldPath *f
 	return resourceRequests, allErrs
 }
 
-func validateInstanceRuntimeSpec(spec computev1alpha.InstanceRuntimeSpec, volumes map[string]computev1alpha.VolumeSource, fieldPath *field.Path) field.ErrorList {
+func validateInstanceRuntimeSpec(
+	spec computev1alpha.InstanceRuntimeSpec,
+	volumes map[string]computev1alpha.VolumeSource,
+	fieldPath *field.Path,
+	opts WorkloadValidationOptions,
+) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	allErrs = append(allErrs, validateInstanceRuntimeResources(spec.Resources, fieldPath.Child("resources"))...)
+	instanceType, resourcesErrs := validateInstanceRuntimeResources(spec.Resources, opts.InstanceTypes, fieldPath.Child("resources"))
+	allErrs = append(allErrs, resourcesErrs...)
+
+	// The resources provided by the instance type are only known when the
+	// instance type and its requests are valid.
+	var capacity corev1.ResourceList
+	if instanceType != nil && len(resourcesErrs) == 0 {
+		capacity = getInstanceTypeCapacity(instanceType, spec.Resources.Requests)
+	}
 
 	numRuntimes := 0
 
@@ -433,7 +459,8 @@ func validateInstanceRuntimeSpec(spec computev1alpha.InstanceRuntimeSpec, volume
 			allErrs = append(allErrs, field.Forbidden(sandboxField, "may not specify more than 1 runtime type"))
 		} else {
 			numRuntimes++
-			allErrs = append(allErrs, validateSandboxRuntime(spec.Sandbox, volumes, sandboxField)...)
+			allErrs = append(allErrs, validateInstanceTypeRuntime(instanceType, computev1alpha.SandboxInstanceRuntimeType, sandboxField)...)
+			allErrs = append(allErrs, validateSandboxRuntime(spec.Sandbox, spec.Resources.InstanceType, capacity, volumes, sandboxField)...)
 		}
 	}
 
@@ -445,6 +472,7 @@ func validateInstanceRuntimeSpec(spec computev1alpha.InstanceRuntimeSpec, volume
 			allErrs = append(allErrs, field.Forbidden(vmField, "may not specify more than 1 runtime type"))
 		} else {
 			numRuntimes++
+			allErrs = append(allErrs, validateInstanceTypeRuntime(instanceType, computev1alpha.VirtualMachineInstanceRuntimeType, vmField)...)
 			allErrs = append(allErrs, validateVirtualMachineRuntime(spec.VirtualMachine, volumes, vmField)...)
 		}
 	}
@@ -456,10 +484,35 @@ func validateInstanceRuntimeSpec(spec computev1alpha.InstanceRuntimeSpec, volume
 	return allErrs
 }
 
-func validateSandboxRuntime(sandbox *computev1alpha.SandboxRuntime, volumes map[string]computev1alpha.VolumeSource, fieldPath *field.Path) field.ErrorList {
+// validateInstanceTypeRuntime validates that the runtime is supported by the
+// instance type, when the instance type is known.
+func validateInstanceTypeRuntime(
+	instanceType *computev1alpha.InstanceType,
+	runtime computev1alpha.InstanceRuntimeType,
+	fieldPath *field.Path,
+) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	allErrs = append(allErrs, validateSandboxContainers(sandbox.Containers, volumes, fieldPath.Child("containers"))...)
+	if instanceType != nil && !slices.Contains(instanceType.Spec.Runtimes, runtime) {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, fmt.Sprintf("runtime %s is not supported by the instance type", runtime)))
+	}
+
+	return allErrs
+}
+
+func validateSandboxRuntime(
+	sandbox *computev1alpha.SandboxRuntime,
+	instanceType string,
+	capacity corev1.ResourceList,
+	volumes map[string]computev1alpha.VolumeSource,
+	fieldPath *field.Path,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSandboxContainers(sandbox.Containers, sandbox.InitContainers, volumes, fieldPath)...)
+	if capacity != nil {
+		allErrs = append(allErrs, validateSandboxResources(sandbox, instanceType, capacity, fieldPath)...)
+	}
 	allErrs = append(allErrs, validateImagePullSecrets(sandbox.ImagePullSecrets, fieldPath.Child("imagePullSecrets"))...)
 
 	return allErrs
@@ -479,20 +532,59 @@ func validateImagePullSecrets(imagePullSecrets []computev1alpha.LocalSecretRefer
 
 func validateSandboxContainers(
 	containers []computev1alpha.SandboxContainer,
+	initContainers []computev1alpha.SandboxContainer,
 	volumes map[string]computev1alpha.VolumeSource,
 	fieldPath *field.Path,
 ) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	containersPath := fieldPath.Child("containers")
 	if len(containers) == 0 {
-		return append(allErrs, field.Required(fieldPath, "must define at least 1 container"))
+		allErrs = append(allErrs, field.Required(containersPath, "must define at least 1 container"))
 	}
 
+	// Container names must be unique across containers and init containers.
 	allNames := sets.Set[string]{}
 	for i, c := range containers {
-		indexPath := fieldPath.Index(i)
+		indexPath := containersPath.Index(i)
 		allErrs = append(allErrs, validateContainerCommon(c, volumes, indexPath)...)
 
+		if c.RestartPolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(indexPath.Child("restartPolicy"), "may only be provided for init containers"))
+		}
+
+		if allNames.Has(c.Name) {
+			allErrs = append(allErrs, field.Duplicate(indexPath.Child("name"), c.Name))
+		} else {
+			allNames.Insert(c.Name)
+		}
+	}
+
+	initContainersPath := fieldPath.Child("initContainers")
+	for i, c := range initContainers {
+		indexPath := initContainersPath.Index(i)
+		allErrs = append(allErrs, validateContainerCommon(c, volumes, indexPath)...)
+
+		if c.RestartPolicy != nil && !supportedContainerRestartPolicies.Has(*c.RestartPolicy) {
+			allErrs = append(allErrs, field.NotSupported(indexPath.Child("restartPolicy"), *c.RestartPolicy, sets.List(supportedContainerRestartPolicies)))
+		}
+
+		// Init containers which run to completion are never ready, so only sidecar
+		// containers may be probed.
+		if c.RestartPolicy == nil {
+			if c.ReadinessProbe != nil {
+				allErrs = append(allErrs, field.Forbidden(indexPath.Child("readinessProbe"), "may only be provided for sidecar containers"))
+			}
+
+			if c.LivenessProbe != nil {
+				allErrs = append(allErrs, field.Forbidden(indexPath.Child("livenessProbe"), "may only be provided for sidecar containers"))
+			}
+
+			if c.StartupProbe != nil {
+				allErrs = append(allErrs, field.Forbidden(indexPath.Child("startupProbe"), "may only be provided for sidecar containers"))
+			}
+		}
+
 		if allNames.Has(c.Name) {
 			allErrs = append(allErrs, field.Duplicate(indexPath.Child("name"), c.Name))
 		} else {
@@ -503,6 +595,10 @@ func validateSandboxContainers(
 	return allErrs
 }
 
+var supportedContainerRestartPolicies = sets.New(
+	computev1alpha.AlwaysContainerRestartPolicy,
+)
+
 func validateContainerCommon(
 	container computev1alpha.SandboxContainer,
 	volumes map[string]computev1alpha.VolumeSource,
@@ -526,9 +622,25 @@ func validateContainerCommon(
 	}
 
 	if container.Resources != nil {
-		// TODO(jreese) validate resource requirements
-		// https://github.com/kubernetes/kubernetes/blob/f1e447b9d32ac325074380d239370cde02a6dbf7/pkg/apis/core/validation/validation.go#L6699
-		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("resources"), "not implemented"))
+		allErrs = append(allErrs, validateContainerResourceRequirements(container.Resources, fieldPath.Child("resources"))...)
+	}
+
+	allErrs = append(allErrs, validateEnv(container.Env, fieldPath.Child("env"))...)
+
+	allErrs = append(allErrs, validateContainerArgs(container.Command, fieldPath.Child("command"))...)
+	allErrs = append(allErrs, validateContainerArgs(container.Args, fieldPath.Child("args"))...)
+
+	if len(container.WorkingDir) > 0 {
+		workingDirField := fieldPath.Child("workingDir")
+		if !strings.HasPrefix(container.WorkingDir, "/") {
+			allErrs = append(allErrs, field.Invalid(workingDirField, container.WorkingDir, "must be an absolute path"))
+		} else if len(container.WorkingDir) > maxContainerWorkingDirLength {
+			allErrs = append(allErrs, field.TooLong(workingDirField, "", maxContainerWorkingDirLength))
+		}
+	}
+
+	if container.SecurityContext != nil {
+		allErrs = append(allErrs, validateContainerSecurityContext(container.SecurityContext, fieldPath.Child("securityContext"))...)
 	}
 
 	allErrs = append(allErrs, validateVolumeAttachments(container.VolumeAttachments, volumes, fieldPath.Child("volumeAttachments"))...)
@@ -536,9 +648,381 @@ func validateContainerCommon(
 	// TODO(jreese) validate named ports are unique across all containers?
 	allErrs = append(allErrs, validateNamedPorts(container.Ports, fieldPath.Child("ports"))...)
 
+	allErrs = append(allErrs, validateProbes(container.ReadinessProbe, container.LivenessProbe, container.StartupProbe, container.Ports, true, fieldPath)...)
+
+	return allErrs
+}
+
+const (
+	maxContainerArgs             = 256
+	maxContainerArgLength        = 4096
+	maxContainerWorkingDirLength = 4096
+	maxContainerUserID           = math.MaxInt32
+)
+
+// supportedAddedCapabilities are the capabilities which may be added to a
+// container. The set matches the default capabilities granted to containers by
+// common container runtimes.
+var supportedAddedCapabilities = sets.New[computev1alpha.Capability](
+	"AUDIT_WRITE",
+	"CHOWN",
+	"DAC_OVERRIDE",
+	"FOWNER",
+	"FSETID",
+	"KILL",
+	"MKNOD",
+	"NET_BIND_SERVICE",
+	"NET_RAW",
+	"SETFCAP",
+	"SETGID",
+	"SETPCAP",
+	"SETUID",
+	"SYS_CHROOT",
+)
+
+var supportedInstanceFieldPaths = sets.New(
+	computev1alpha.InstanceNameFieldPath,
+	computev1alpha.InstanceNamespaceFieldPath,
+	computev1alpha.InstanceOrdinalFieldPath,
+	computev1alpha.InstanceLocationFieldPath,
+	computev1alpha.InstanceCityCodeFieldPath,
+	computev1alpha.InstanceNetworkIPFieldPath,
+)
+
+func validateEnv(env []computev1alpha.EnvVar, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allNames := sets.Set[string]{}
+
+	for i, envVar := range env {
+		indexPath := fieldPath.Index(i)
+		nameField := indexPath.Child("name")
+
+		if len(envVar.Name) == 0 {
+			allErrs = append(allErrs, field.Required(nameField, ""))
+		} else {
+			for _, msg := range apimachineryutilvalidation.IsEnvVarName(envVar.Name) {
+				allErrs = append(allErrs, field.Invalid(nameField, envVar.Name, msg))
+			}
+			if allNames.Has(envVar.Name) {
+				allErrs = append(allErrs, field.Duplicate(nameField, envVar.Name))
+			} else {
+				allNames.Insert(envVar.Name)
+			}
+		}
+
+		if envVar.ValueFrom != nil {
+			valueFromPath := indexPath.Child("valueFrom")
+			if len(envVar.Value) > 0 {
+				allErrs = append(allErrs, field.Forbidden(valueFromPath, "may not be provided when value is provided"))
+			}
+			allErrs = append(allErrs, validateEnvVarSource(*envVar.ValueFrom, valueFromPath)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateEnvVarSource(source computev1alpha.EnvVarSource, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	numSources := 0
+
+	if source.SecretKeyRef != nil {
+		secretKeyRefPath := fieldPath.Child("secretKeyRef")
+		if numSources > 0 {
+			allErrs = append(allErrs, field.Forbidden(secretKeyRefPath, "may not specify more than 1 source"))
+		} else {
+			numSources++
+			allErrs = append(allErrs, validateKeySelector(source.SecretKeyRef.Name, source.SecretKeyRef.Key, secretKeyRefPath)...)
+		}
+	}
+
+	if source.ConfigMapKeyRef != nil {
+		configMapKeyRefPath := fieldPath.Child("configMapKeyRef")
+		if numSources > 0 {
+			allErrs = append(allErrs, field.Forbidden(configMapKeyRefPath, "may not specify more than 1 source"))
+		} else {
+			numSources++
+			allErrs = append(allErrs, validateKeySelector(source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key, configMapKeyRefPath)...)
+		}
+	}
+
+	if source.InstanceFieldRef != nil {
+		instanceFieldRefPath := fieldPath.Child("instanceFieldRef")
+		if numSources > 0 {
+			allErrs = append(allErrs, field.Forbidden(instanceFieldRefPath, "may not specify more than 1 source"))
+		} else {
+			numSources++
+			allErrs = append(allErrs, validateInstanceFieldSelector(*source.InstanceFieldRef, instanceFieldRefPath)...)
+		}
+	}
+
+	if numSources == 0 {
+		allErrs = append(allErrs, field.Required(fieldPath, "must specify one of: `secretKeyRef`, `configMapKeyRef` or `instanceFieldRef`"))
+	}
+
+	return allErrs
+}
+
+func validateKeySelector(name, key string, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	nameField := fieldPath.Child("name")
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(nameField, ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(nameField, name, msg))
+		}
+	}
+
+	keyField := fieldPath.Child("key")
+	if len(key) == 0 {
+		allErrs = append(allErrs, field.Required(keyField, ""))
+	} else {
+		for _, msg := range apimachineryutilvalidation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(keyField, key, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateInstanceFieldSelector(selector computev1alpha.InstanceFieldSelector, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedInstanceFieldPaths.Has(selector.FieldPath) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("fieldPath"), selector.FieldPath, sets.List(supportedInstanceFieldPaths)))
+	}
+
+	if selector.NetworkInterfaceIndex != nil {
+		networkInterfaceIndexPath := fieldPath.Child("networkInterfaceIndex")
+		if selector.FieldPath != computev1alpha.InstanceNetworkIPFieldPath {
+			allErrs = append(allErrs, field.Forbidden(networkInterfaceIndexPath, fmt.Sprintf("may only be provided when fieldPath is %s", computev1alpha.InstanceNetworkIPFieldPath)))
+		} else if *selector.NetworkInterfaceIndex < 0 {
+			allErrs = append(allErrs, field.Invalid(networkInterfaceIndexPath, *selector.NetworkInterfaceIndex, "must be greater than or equal to 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateContainerArgs(args []string, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(args) > maxContainerArgs {
+		allErrs = append(allErrs, field.TooMany(fieldPath, len(args), maxContainerArgs))
+	}
+
+	for i, arg := range args {
+		if len(arg) > maxContainerArgLength {
+			allErrs = append(allErrs, field.TooLong(fieldPath.Index(i), "", maxContainerArgLength))
+		}
+	}
+
+	return allErrs
+}
+
+func validateContainerSecurityContext(securityContext *computev1alpha.ContainerSecurityContext, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	runAsUserField := fieldPath.Child("runAsUser")
+	if securityContext.RunAsUser != nil {
+		allErrs = append(allErrs, validateContainerUserID(*securityContext.RunAsUser, runAsUserField)...)
+
+		if *securityContext.RunAsUser == 0 && ptr.Deref(securityContext.RunAsNonRoot, false) {
+			allErrs = append(allErrs, field.Invalid(runAsUserField, *securityContext.RunAsUser, "must not be 0 when runAsNonRoot is true"))
+		}
+	}
+
+	if securityContext.RunAsGroup != nil {
+		allErrs = append(allErrs, validateContainerUserID(*securityContext.RunAsGroup, fieldPath.Child("runAsGroup"))...)
+	}
+
+	if capabilities := securityContext.Capabilities; capabilities != nil {
+		capabilitiesPath := fieldPath.Child("capabilities")
+
+		addPath := capabilitiesPath.Child("add")
+		added := sets.New[computev1alpha.Capability]()
+		for i, capability := range capabilities.Add {
+			indexPath := addPath.Index(i)
+			if !supportedAddedCapabilities.Has(capability) {
+				allErrs = append(allErrs, field.NotSupported(indexPath, capability, sets.List(supportedAddedCapabilities)))
+			} else if added.Has(capability) {
+				allErrs = append(allErrs, field.Duplicate(indexPath, capability))
+			} else {
+				added.Insert(capability)
+			}
+		}
+
+		dropPath := capabilitiesPath.Child("drop")
+		dropped := sets.New[computev1alpha.Capability]()
+		for i, capability := range capabilities.Drop {
+			indexPath := dropPath.Index(i)
+			if len(capability) == 0 {
+				allErrs = append(allErrs, field.Required(indexPath, ""))
+			} else if dropped.Has(capability) {
+				allErrs = append(allErrs, field.Duplicate(indexPath, capability))
+			} else {
+				dropped.Insert(capability)
+			}
+		}
+	}
+
 	return allErrs
 }
 
+func validateContainerUserID(id int64, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if id < 0 || id > maxContainerUserID {
+		allErrs = append(allErrs, field.Invalid(fieldPath, id, fmt.Sprintf("must be between 0 and %d, inclusive", maxContainerUserID)))
+	}
+
+	return allErrs
+}
+
+func validateContainerResourceRequirements(requirements *computev1alpha.ContainerResourceRequirements, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	limitsPath := fieldPath.Child("limits")
+	for _, name := range sortedResourceNames(requirements.Limits) {
+		allErrs = append(allErrs, validatePositiveQuantityValue(requirements.Limits[name], limitsPath.Key(string(name)))...)
+	}
+
+	requestsPath := fieldPath.Child("requests")
+	for _, name := range sortedResourceNames(requirements.Requests) {
+		requestPath := requestsPath.Key(string(name))
+		request := requirements.Requests[name]
+		if errs := validatePositiveQuantityValue(request, requestPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else if limit, ok := requirements.Limits[name]; ok && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(requestPath, request.String(), fmt.Sprintf("must be less than or equal to %s limit of %s", name, limit.String())))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSandboxResources validates the resource requests and limits of the
+// sandbox's containers against the resources provided by the instance type.
+//
+// Containers and sidecar containers run at the same time, so their requests
+// must fit within the instance type's resources together. When limits for a
+// resource are defined on all of them, the limits must consume the entire
+// amount of the resource. Init containers which run to completion only need to
+// fit within the instance type's resources on their own.
+func validateSandboxResources(
+	sandbox *computev1alpha.SandboxRuntime,
+	instanceType string,
+	capacity corev1.ResourceList,
+	fieldPath *field.Path,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	type sandboxContainer struct {
+		container computev1alpha.SandboxContainer
+		path      *field.Path
+		// Whether the container runs alongside the sandbox's containers.
+		longRunning bool
+	}
+
+	var containers []sandboxContainer
+	for i, c := range sandbox.Containers {
+		containers = append(containers, sandboxContainer{c, fieldPath.Child("containers").Index(i), true})
+	}
+	for i, c := range sandbox.InitContainers {
+		containers = append(containers, sandboxContainer{c, fieldPath.Child("initContainers").Index(i), c.RestartPolicy != nil})
+	}
+
+	supportedResources := sortedResourceNames(capacity)
+	totalRequests := corev1.ResourceList{}
+	totalLimits := corev1.ResourceList{}
+	limitedContainers := map[corev1.ResourceName]int{}
+	longRunningContainers := 0
+
+	for _, c := range containers {
+		if c.longRunning {
+			longRunningContainers++
+		}
+
+		if c.container.Resources == nil {
+			continue
+		}
+
+		resourcesPath := c.path.Child("resources")
+		for _, list := range []struct {
+			name      string
+			resources corev1.ResourceList
+			total     corev1.ResourceList
+		}{
+			{"limits", c.container.Resources.Limits, totalLimits},
+			{"requests", c.container.Resources.Requests, totalRequests},
+		} {
+			for _, name := range sortedResourceNames(list.resources) {
+				resourcePath := resourcesPath.Child(list.name).Key(string(name))
+				quantity := list.resources[name]
+
+				available, ok := capacity[name]
+				if !ok {
+					allErrs = append(allErrs, field.NotSupported(resourcePath, name, supportedResources))
+					continue
+				}
+
+				if quantity.Cmp(available) > 0 {
+					allErrs = append(allErrs, field.Invalid(resourcePath, quantity.String(), fmt.Sprintf("must be less than or equal to the %s provided by instance type %s (%s)", name, instanceType, available.String())))
+				}
+
+				if !c.longRunning {
+					continue
+				}
+
+				total := list.total[name]
+				total.Add(quantity)
+				list.total[name] = total
+
+				if list.name == "limits" {
+					limitedContainers[name]++
+				}
+			}
+		}
+	}
+
+	containersPath := fieldPath.Child("containers")
+	for _, name := range supportedResources {
+		available := capacity[name]
+
+		if total, ok := totalRequests[name]; ok && total.Cmp(available) > 0 {
+			allErrs = append(allErrs, field.Invalid(containersPath, total.String(), fmt.Sprintf("total %s requests must be less than or equal to the %s provided by instance type %s (%s)", name, name, instanceType, available.String())))
+		}
+
+		if longRunningContainers > 0 && limitedContainers[name] == longRunningContainers {
+			if total := totalLimits[name]; total.Cmp(available) != 0 {
+				allErrs = append(allErrs, field.Invalid(containersPath, total.String(), fmt.Sprintf("total %s limits must equal the %s provided by instance type %s (%s) when defined on all containers", name, name, instanceType, available.String())))
+			}
+		}
+
+		// Resources such as GPUs are only presented to containers which request
+		// them.
+		if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+			if _, ok := totalRequests[name]; !ok {
+				allErrs = append(allErrs, field.Required(containersPath, fmt.Sprintf("at least one container must request %s", name)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func sortedResourceNames(resources corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
 func validateVirtualMachineRuntime(vm *computev1alpha.VirtualMachineRuntime, volumes map[string]computev1alpha.VolumeSource, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -564,6 +1048,152 @@ func validateVirtualMachineRuntime(vm *computev1alpha.VirtualMachineRuntime, vol
 
 	allErrs = append(allErrs, validateNamedPorts(vm.Ports, fieldPath.Child("ports"))...)
 
+	allErrs = append(allErrs, validateProbes(vm.ReadinessProbe, vm.LivenessProbe, vm.StartupProbe, vm.Ports, false, fieldPath)...)
+
+	return allErrs
+}
+
+// validateProbes validates the readiness, liveness, and startup probes of a
+// container or virtual machine. Exec probes are only allowed for containers.
+func validateProbes(
+	readinessProbe *computev1alpha.Probe,
+	livenessProbe *computev1alpha.Probe,
+	startupProbe *computev1alpha.Probe,
+	ports []computev1alpha.NamedPort,
+	allowExec bool,
+	fieldPath *field.Path,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if readinessProbe != nil {
+		allErrs = append(allErrs, validateProbe(readinessProbe, ports, allowExec, fieldPath.Child("readinessProbe"))...)
+	}
+
+	// Liveness and startup probes must succeed once for the container or
+	// virtual machine to be considered alive or started.
+	if livenessProbe != nil {
+		livenessProbePath := fieldPath.Child("livenessProbe")
+		allErrs = append(allErrs, validateProbe(livenessProbe, ports, allowExec, livenessProbePath)...)
+		if livenessProbe.SuccessThreshold > 1 {
+			allErrs = append(allErrs, field.Invalid(livenessProbePath.Child("successThreshold"), livenessProbe.SuccessThreshold, "must be 1"))
+		}
+	}
+
+	if startupProbe != nil {
+		startupProbePath := fieldPath.Child("startupProbe")
+		allErrs = append(allErrs, validateProbe(startupProbe, ports, allowExec, startupProbePath)...)
+		if startupProbe.SuccessThreshold > 1 {
+			allErrs = append(allErrs, field.Invalid(startupProbePath.Child("successThreshold"), startupProbe.SuccessThreshold, "must be 1"))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedURISchemes = sets.New(
+	computev1alpha.URISchemeHTTP,
+	computev1alpha.URISchemeHTTPS,
+)
+
+func validateProbe(probe *computev1alpha.Probe, ports []computev1alpha.NamedPort, allowExec bool, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	numHandlers := 0
+
+	if probe.Exec != nil {
+		execPath := fieldPath.Child("exec")
+		numHandlers++
+		if !allowExec {
+			allErrs = append(allErrs, field.Forbidden(execPath, "exec probes are not supported for virtual machines"))
+		} else if len(probe.Exec.Command) == 0 {
+			allErrs = append(allErrs, field.Required(execPath.Child("command"), ""))
+		}
+	}
+
+	if probe.HTTPGet != nil {
+		httpGetPath := fieldPath.Child("httpGet")
+		if numHandlers > 0 {
+			allErrs = append(allErrs, field.Forbidden(httpGetPath, "may not specify more than 1 handler type"))
+		} else {
+			numHandlers++
+			allErrs = append(allErrs, validateHTTPGetAction(probe.HTTPGet, ports, httpGetPath)...)
+		}
+	}
+
+	if probe.TCPSocket != nil {
+		tcpSocketPath := fieldPath.Child("tcpSocket")
+		if numHandlers > 0 {
+			allErrs = append(allErrs, field.Forbidden(tcpSocketPath, "may not specify more than 1 handler type"))
+		} else {
+			numHandlers++
+			allErrs = append(allErrs, validateProbePort(probe.TCPSocket.Port, ports, tcpSocketPath.Child("port"))...)
+		}
+	}
+
+	if numHandlers == 0 {
+		allErrs = append(allErrs, field.Required(fieldPath, "must specify a handler type"))
+	}
+
+	// A value of 0 results in the default being used.
+	allErrs = append(allErrs, validateNonNegativeProbeField(probe.InitialDelaySeconds, fieldPath.Child("initialDelaySeconds"))...)
+	allErrs = append(allErrs, validateNonNegativeProbeField(probe.TimeoutSeconds, fieldPath.Child("timeoutSeconds"))...)
+	allErrs = append(allErrs, validateNonNegativeProbeField(probe.PeriodSeconds, fieldPath.Child("periodSeconds"))...)
+	allErrs = append(allErrs, validateNonNegativeProbeField(probe.SuccessThreshold, fieldPath.Child("successThreshold"))...)
+	allErrs = append(allErrs, validateNonNegativeProbeField(probe.FailureThreshold, fieldPath.Child("failureThreshold"))...)
+
+	return allErrs
+}
+
+func validateNonNegativeProbeField(value int32, fieldPath *field.Path) field.ErrorList {
+	if value < 0 {
+		return field.ErrorList{field.Invalid(fieldPath, value, "must be greater than or equal to 0")}
+	}
+	return nil
+}
+
+func validateHTTPGetAction(action *computev1alpha.HTTPGetAction, ports []computev1alpha.NamedPort, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(action.Path) > 0 && !strings.HasPrefix(action.Path, "/") {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("path"), action.Path, "must be an absolute path"))
+	}
+
+	allErrs = append(allErrs, validateProbePort(action.Port, ports, fieldPath.Child("port"))...)
+
+	if len(action.Scheme) > 0 && !supportedURISchemes.Has(action.Scheme) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("scheme"), action.Scheme, sets.List(supportedURISchemes)))
+	}
+
+	for i, header := range action.HTTPHeaders {
+		nameField := fieldPath.Child("httpHeaders").Index(i).Child("name")
+		if len(header.Name) == 0 {
+			allErrs = append(allErrs, field.Required(nameField, ""))
+		} else {
+			for _, msg := range apimachineryutilvalidation.IsHTTPHeaderName(header.Name) {
+				allErrs = append(allErrs, field.Invalid(nameField, header.Name, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateProbePort validates a probe's port, which must either be a valid
+// port number, or refer to one of the named ports.
+func validateProbePort(port intstr.IntOrString, ports []computev1alpha.NamedPort, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if port.Type == intstr.Int {
+		for _, msg := range apimachineryutilvalidation.IsValidPortNum(port.IntValue()) {
+			allErrs = append(allErrs, field.Invalid(fieldPath, port.IntValue(), msg))
+		}
+		return allErrs
+	}
+
+	if !slices.ContainsFunc(ports, func(p computev1alpha.NamedPort) bool { return p.Name == port.StrVal }) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, port.StrVal, "must refer to a named port"))
+	}
+
 	return allErrs
 }
 
@@ -653,17 +1283,96 @@ func validateNamedPorts(ports []computev1alpha.NamedPort, fieldPath *field.Path)
 	return allErrs
 }
 
-func validateInstanceRuntimeResources(resources computev1alpha.InstanceRuntimeResources, fieldPath *field.Path) field.ErrorList {
+// validateInstanceRuntimeResources validates that the instance type has been
+// declared by an InstanceType, and that any requests are within the ranges
+// supported by the instance type. The declared InstanceType is returned when it
+// is found.
+func validateInstanceRuntimeResources(
+	resources computev1alpha.InstanceRuntimeResources,
+	instanceTypes []computev1alpha.InstanceType,
+	fieldPath *field.Path,
+) (*computev1alpha.InstanceType, field.ErrorList) {
+	allErrs := field.ErrorList{}
+
+	name := computev1alpha.InstanceTypeName(resources.InstanceType)
+	i := slices.IndexFunc(instanceTypes, func(instanceType computev1alpha.InstanceType) bool {
+		return instanceType.Name == name
+	})
+	if i == -1 {
+		supportedInstanceTypes := sets.New[string]()
+		for _, instanceType := range instanceTypes {
+			supportedInstanceTypes.Insert(strings.Replace(instanceType.Name, ".", "/", 1))
+		}
+		allErrs = append(allErrs, field.NotSupported(fieldPath, resources.InstanceType, sets.List(supportedInstanceTypes)))
+		return nil, allErrs
+	}
+	instanceType := &instanceTypes[i]
+
+	requestsPath := fieldPath.Child("requests")
+	custom := instanceType.Spec.Custom
+	if custom == nil {
+		if len(resources.Requests) > 0 {
+			allErrs = append(allErrs, field.Forbidden(requestsPath, fmt.Sprintf("instance type %s does not support customization", resources.InstanceType)))
+		}
+		return instanceType, allErrs
+	}
+
+	ranges := map[corev1.ResourceName]computev1alpha.ResourceRange{
+		corev1.ResourceCPU:    custom.CPU,
+		corev1.ResourceMemory: custom.Memory,
+	}
+
+	for _, name := range sortedResourceNames(resources.Requests) {
+		if _, ok := ranges[name]; !ok {
+			allErrs = append(allErrs, field.NotSupported(requestsPath.Key(string(name)), name, []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}))
+		}
+	}
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request, ok := resources.Requests[name]
+		if !ok {
+			allErrs = append(allErrs, field.Required(requestsPath.Key(string(name)), fmt.Sprintf("must be provided for instance type %s", resources.InstanceType)))
+			continue
+		}
+		allErrs = append(allErrs, validateResourceRange(request, ranges[name], requestsPath.Key(string(name)))...)
+	}
+
+	return instanceType, allErrs
+}
+
+func validateResourceRange(quantity resource.Quantity, resourceRange computev1alpha.ResourceRange, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	// TODO(jreese) look up available instance types
-	if resources.InstanceType != "datumcloud/d1-standard-2" {
-		allErrs = append(allErrs, field.NotSupported(fieldPath, resources.InstanceType, []string{"datumcloud/d1-standard-2"}))
+	if quantity.Cmp(resourceRange.Min) < 0 || quantity.Cmp(resourceRange.Max) > 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, quantity.String(), fmt.Sprintf("must be between %s and %s", resourceRange.Min.String(), resourceRange.Max.String())))
 	}
 
-	if resources.Requests != nil {
-		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("requests"), "not implemented"))
+	if increment := resourceRange.Increment; increment != nil && !increment.IsZero() {
+		if quantity.MilliValue()%increment.MilliValue() != 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath, quantity.String(), fmt.Sprintf("must be a multiple of %s", increment.String())))
+		}
 	}
 
 	return allErrs
 }
+
+// getInstanceTypeCapacity returns the resources provided to an instance of the
+// instance type. Instance types which support customization provide the CPU
+// and memory requested by the instance.
+func getInstanceTypeCapacity(instanceType *computev1alpha.InstanceType, requests corev1.ResourceList) corev1.ResourceList {
+	capacity := instanceType.Spec.Resources.DeepCopy()
+	if instanceType.Spec.Custom == nil {
+		return capacity
+	}
+
+	if capacity == nil {
+		capacity = corev1.ResourceList{}
+	}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if request, ok := requests[name]; ok {
+			capacity[name] = request.DeepCopy()
+		}
+	}
+
+	return capacity
+}
@@ -2,16 +2,28 @@ package validation
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+	"go.datum.net/workload-operator/internal/controller/instancecontrol"
 )
 
 // Great reference:
@@ -21,7 +33,18 @@ func ValidateWorkloadCreate(w *computev1alpha.Workload, opts WorkloadValidationO
 	allErrs := field.ErrorList{}
 
 	// allErrs = append(allErrs, validateWorkloadMetadata(w)...)
-	allErrs = append(allErrs, validateWorkloadSpec(w.Spec, opts)...)
+	allErrs = append(allErrs, validateWorkloadSpec(w.Spec, nil, opts)...)
+
+	return allErrs
+}
+
+// ValidateWorkloadUpdate validates the parts of the workload's spec which have
+// changed. Unchanged parts are not validated again, so that updates are not
+// rejected when a location or instance type they refer to has been removed.
+func ValidateWorkloadUpdate(newWorkload, oldWorkload *computev1alpha.Workload, opts WorkloadValidationOptions) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateWorkloadSpec(newWorkload.Spec, &oldWorkload.Spec, opts)...)
 
 	return allErrs
 }
@@ -32,33 +55,275 @@ type WorkloadValidationOptions struct {
 	Context          context.Context
 	Workload         *computev1alpha.Workload
 	ValidCityCodes   []string
+
+	// InstanceTypes are the instance types which instances may use.
+	InstanceTypes []computev1alpha.InstanceType
 }
 
-func validateWorkloadSpec(spec computev1alpha.WorkloadSpec, opts WorkloadValidationOptions) field.ErrorList {
+// validateWorkloadSpec validates the workload's spec. When the spec of the
+// workload prior to an update is provided, only the parts of the spec which
+// have changed are validated.
+func validateWorkloadSpec(spec computev1alpha.WorkloadSpec, oldSpec *computev1alpha.WorkloadSpec, opts WorkloadValidationOptions) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	specPath := field.NewPath("spec")
 
-	allErrs = append(allErrs, validateInstanceTemplate(spec.Template, specPath.Child("template"), opts)...)
-	allErrs = append(allErrs, validateWorkloadPlacements(spec.Placements, specPath.Child("placements"), opts)...)
+	templateChanged := oldSpec == nil || !equality.Semantic.DeepEqual(spec.Template, oldSpec.Template)
+
+	var templateErrs field.ErrorList
+	if templateChanged {
+		templateErrs = validateInstanceTemplate(spec.Template, specPath.Child("template"), opts)
+		allErrs = append(allErrs, templateErrs...)
+	}
+
+	var oldPlacements []computev1alpha.WorkloadPlacement
+	if oldSpec != nil {
+		oldPlacements = oldSpec.Placements
+	}
+
+	placementsPath := specPath.Child("placements")
+	placementErrs := validateWorkloadPlacements(spec.Placements, oldPlacements, placementsPath, opts)
+	allErrs = append(allErrs, placementErrs...)
+
+	// Templates resulting from placement overrides are only validated when the
+	// workload's template is valid, to avoid reporting the same errors again.
+	if len(templateErrs) == 0 && len(placementErrs) == 0 {
+		for i, placement := range spec.Placements {
+			if templateChanged || placementChanged(placement, oldPlacements) {
+				allErrs = append(allErrs, validateOverriddenTemplates(placement, spec.Template, placementsPath.Index(i), opts)...)
+			}
+		}
+	}
+
+	if spec.Gateway != nil && (templateChanged || !equality.Semantic.DeepEqual(spec.Gateway, oldSpec.Gateway)) {
+		allErrs = append(allErrs, validateWorkloadGateway(*spec.Gateway, spec.Template, specPath.Child("gateway"))...)
+	}
+
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, validateWorkloadRolloutStrategy(*spec.RolloutStrategy, specPath.Child("rolloutStrategy"))...)
+	}
+
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must be greater than or equal to 0"))
+	}
+
+	if spec.RollbackTo != nil && spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("rollbackTo", "revision"), spec.RollbackTo.Revision, "must be greater than or equal to 0"))
+	}
+
+	if spec.Restart != nil && (oldSpec == nil || !equality.Semantic.DeepEqual(spec.Restart, oldSpec.Restart)) {
+		allErrs = append(allErrs, validateInstanceRestartRequest(*spec.Restart, specPath.Child("restart"))...)
+	}
 
 	return allErrs
 }
 
-func validateWorkloadPlacements(placements []computev1alpha.WorkloadPlacement, fieldPath *field.Path, opts WorkloadValidationOptions) field.ErrorList {
+// maxRestartRequestClockSkew is how far in the future a restart request may be
+// made, to allow for differences between the clocks of clients and the webhook.
+const maxRestartRequestClockSkew = 5 * time.Minute
+
+var supportedInstanceRestartTypes = sets.New(
+	computev1alpha.RestartInstanceRestartType,
+	computev1alpha.RecreateInstanceRestartType,
+)
+
+func validateInstanceRestartRequest(request computev1alpha.InstanceRestartRequest, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if request.RequestedAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("requestedAt"), ""))
+	} else if latest := metav1.NewTime(time.Now().Add(maxRestartRequestClockSkew)); latest.Before(&request.RequestedAt) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("requestedAt"), request.RequestedAt, "must not be in the future"))
+	}
+
+	if len(request.Type) > 0 && !supportedInstanceRestartTypes.Has(request.Type) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("type"), request.Type, sets.List(supportedInstanceRestartTypes)))
+	}
+
+	return allErrs
+}
+
+const maxRolloutSeconds int32 = 86400
+
+var supportedWorkloadRolloutStrategyTypes = sets.New(
+	computev1alpha.AllAtOnceWorkloadRolloutStrategyType,
+	computev1alpha.ProgressiveWorkloadRolloutStrategyType,
+)
+
+var supportedRolloutWaveTypes = sets.New(
+	computev1alpha.PlacementRolloutWaveType,
+	computev1alpha.CityRolloutWaveType,
+)
+
+func validateWorkloadRolloutStrategy(strategy computev1alpha.WorkloadRolloutStrategy, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedWorkloadRolloutStrategyTypes.Has(strategy.Type) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("type"), strategy.Type, sets.List(supportedWorkloadRolloutStrategyTypes)))
+	}
+
+	progressive := strategy.Progressive
+	if progressive == nil {
+		return allErrs
+	}
+
+	progressivePath := fieldPath.Child("progressive")
+	if strategy.Type != computev1alpha.ProgressiveWorkloadRolloutStrategyType {
+		allErrs = append(allErrs, field.Forbidden(progressivePath, "may only be provided when type is Progressive"))
+		return allErrs
+	}
+
+	if len(progressive.WaveBy) > 0 && !supportedRolloutWaveTypes.Has(progressive.WaveBy) {
+		allErrs = append(allErrs, field.NotSupported(progressivePath.Child("waveBy"), progressive.WaveBy, sets.List(supportedRolloutWaveTypes)))
+	}
+
+	if soakSeconds := progressive.SoakSeconds; soakSeconds != nil && (*soakSeconds < 0 || *soakSeconds > maxRolloutSeconds) {
+		allErrs = append(allErrs, field.Invalid(progressivePath.Child("soakSeconds"), *soakSeconds, fmt.Sprintf("must be between 0 and %d", maxRolloutSeconds)))
+	}
+
+	if deadlineSeconds := progressive.ProgressDeadlineSeconds; deadlineSeconds != nil && (*deadlineSeconds <= 0 || *deadlineSeconds > maxRolloutSeconds) {
+		allErrs = append(allErrs, field.Invalid(progressivePath.Child("progressDeadlineSeconds"), *deadlineSeconds, fmt.Sprintf("must be between 1 and %d", maxRolloutSeconds)))
+	}
+
+	return allErrs
+}
+
+func validateWorkloadGateway(
+	gateway computev1alpha.WorkloadGateway,
+	template computev1alpha.InstanceTemplateSpec,
+	fieldPath *field.Path,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	gatewaySpecPath := fieldPath.Child("template", "spec")
+	if len(gateway.Template.Spec.GatewayClassName) == 0 {
+		allErrs = append(allErrs, field.Required(gatewaySpecPath.Child("gatewayClassName"), ""))
+	}
+
+	listenersPath := gatewaySpecPath.Child("listeners")
+	listenerNames := sets.Set[gatewayv1.SectionName]{}
+	if len(gateway.Template.Spec.Listeners) == 0 {
+		allErrs = append(allErrs, field.Required(listenersPath, "must define at least one listener"))
+	}
+
+	for i, listener := range gateway.Template.Spec.Listeners {
+		indexPath := listenersPath.Index(i)
+		listenerNames.Insert(listener.Name)
+
+		// Only TCPRoutes may be attached to the gateway right now.
+		if listener.Protocol != gatewayv1.TCPProtocolType {
+			allErrs = append(allErrs, field.NotSupported(indexPath.Child("protocol"), listener.Protocol, []string{string(gatewayv1.TCPProtocolType)}))
+		}
+	}
+
+	namedPorts := map[string]computev1alpha.NamedPort{}
+	if sandbox := template.Spec.Runtime.Sandbox; sandbox != nil {
+		for _, c := range sandbox.Containers {
+			for _, p := range c.Ports {
+				namedPorts[p.Name] = p
+			}
+		}
+	}
+
+	if vm := template.Spec.Runtime.VirtualMachine; vm != nil {
+		for _, p := range vm.Ports {
+			namedPorts[p.Name] = p
+		}
+	}
+
+	tcpRoutesPath := fieldPath.Child("tcpRoutes")
+	routeNames := sets.Set[string]{}
+	for i, tcpRoute := range gateway.TCPRoutes {
+		routePath := tcpRoutesPath.Index(i)
+
+		namePath := routePath.Child("name")
+		if len(tcpRoute.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, ""))
+		} else {
+			for _, msg := range apimachineryutilvalidation.IsDNS1123Label(tcpRoute.Name) {
+				allErrs = append(allErrs, field.Invalid(namePath, tcpRoute.Name, msg))
+			}
+
+			if routeNames.Has(tcpRoute.Name) {
+				allErrs = append(allErrs, field.Duplicate(namePath, tcpRoute.Name))
+			}
+			routeNames.Insert(tcpRoute.Name)
+		}
+
+		parentRefsPath := routePath.Child("parentRefs")
+		for j, parentRef := range tcpRoute.ParentRefs {
+			parentRefPath := parentRefsPath.Index(j)
+			if parentRef.Name != computev1alpha.WorkloadGatewayParentRefName {
+				allErrs = append(allErrs, field.NotSupported(parentRefPath.Child("name"), parentRef.Name, []string{computev1alpha.WorkloadGatewayParentRefName}))
+			}
+
+			if parentRef.SectionName != nil && !listenerNames.Has(*parentRef.SectionName) {
+				allErrs = append(allErrs, field.NotFound(parentRefPath.Child("sectionName"), *parentRef.SectionName))
+			}
+		}
+
+		rulesPath := routePath.Child("rules")
+		for j, rule := range tcpRoute.Rules {
+			backendRefsPath := rulesPath.Index(j).Child("backendRefs")
+			for k, backendRef := range rule.BackendRefs {
+				backendRefPath := backendRefsPath.Index(k)
+
+				if group := string(ptr.Deref(backendRef.Group, "")); group != computev1alpha.GroupVersion.Group {
+					allErrs = append(allErrs, field.NotSupported(backendRefPath.Child("group"), group, []string{computev1alpha.GroupVersion.Group}))
+				}
+
+				if kind := ptr.Deref(backendRef.Kind, ""); kind != computev1alpha.NamedPortKind {
+					allErrs = append(allErrs, field.NotSupported(backendRefPath.Child("kind"), kind, []string{computev1alpha.NamedPortKind}))
+				}
+
+				if backendRef.Namespace != nil {
+					allErrs = append(allErrs, field.Forbidden(backendRefPath.Child("namespace"), "backends must be in the same namespace as the workload"))
+				}
+
+				namedPort, ok := namedPorts[string(backendRef.Name)]
+				if !ok {
+					allErrs = append(allErrs, field.NotFound(backendRefPath.Child("name"), backendRef.Name))
+				} else if backendRef.Port != nil && int32(*backendRef.Port) != namedPort.Port {
+					allErrs = append(allErrs, field.Invalid(backendRefPath.Child("port"), *backendRef.Port, fmt.Sprintf("must match the port number of named port %q", namedPort.Name)))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkloadPlacements validates the workload's placements. Placements
+// which are unchanged from the provided old placements are not validated.
+func validateWorkloadPlacements(
+	placements []computev1alpha.WorkloadPlacement,
+	oldPlacements []computev1alpha.WorkloadPlacement,
+	fieldPath *field.Path,
+	opts WorkloadValidationOptions,
+) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(placements) == 0 {
 		allErrs = append(allErrs, field.Required(fieldPath, ""))
 	} else {
 		for i, p := range placements {
-			allErrs = append(allErrs, validateWorkloadPlacement(p, fieldPath.Index(i), opts)...)
+			if placementChanged(p, oldPlacements) {
+				allErrs = append(allErrs, validateWorkloadPlacement(p, fieldPath.Index(i), opts)...)
+			}
 		}
 	}
 
 	return allErrs
 }
 
+// placementChanged returns true if the placement is not present, unchanged, in
+// the old placements.
+func placementChanged(placement computev1alpha.WorkloadPlacement, oldPlacements []computev1alpha.WorkloadPlacement) bool {
+	return !slices.ContainsFunc(oldPlacements, func(oldPlacement computev1alpha.WorkloadPlacement) bool {
+		return equality.Semantic.DeepEqual(placement, oldPlacement)
+	})
+}
+
 func validateWorkloadPlacement(placement computev1alpha.WorkloadPlacement, fieldPath *field.Path, opts WorkloadValidationOptions) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -73,7 +338,9 @@ func validateWorkloadPlacement(placement computev1alpha.WorkloadPlacement, field
 
 	cityCodesPath := fieldPath.Child("cityCodes")
 	if len(placement.CityCodes) == 0 {
-		allErrs = append(allErrs, field.Required(cityCodesPath, ""))
+		if placement.LocationSelector == nil {
+			allErrs = append(allErrs, field.Required(cityCodesPath, "must provide cityCodes or locationSelector"))
+		}
 	} else {
 		for i, cityCode := range placement.CityCodes {
 			if !slices.Contains(opts.ValidCityCodes, cityCode) {
@@ -82,8 +349,187 @@ func validateWorkloadPlacement(placement computev1alpha.WorkloadPlacement, field
 		}
 	}
 
+	if placement.LocationSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(
+			placement.LocationSelector,
+			metav1validation.LabelSelectorValidationOptions{},
+			fieldPath.Child("locationSelector"),
+		)...)
+	}
+
 	allErrs = append(allErrs, validateScaleSettings(placement.ScaleSettings, fieldPath.Child("scaleSettings"))...)
 
+	cityScaleSettingsPath := fieldPath.Child("cityScaleSettings")
+	cityScaleSettingsCodes := sets.New[string]()
+	for i, cityScaleSettings := range placement.CityScaleSettings {
+		indexPath := cityScaleSettingsPath.Index(i)
+		cityCodePath := indexPath.Child("cityCode")
+		if !slices.Contains(opts.ValidCityCodes, cityScaleSettings.CityCode) {
+			allErrs = append(allErrs, field.NotSupported(cityCodePath, cityScaleSettings.CityCode, opts.ValidCityCodes))
+		} else if len(placement.CityCodes) > 0 && !slices.Contains(placement.CityCodes, cityScaleSettings.CityCode) {
+			allErrs = append(allErrs, field.Invalid(cityCodePath, cityScaleSettings.CityCode, "must be one of the placement's city codes"))
+		} else if cityScaleSettingsCodes.Has(cityScaleSettings.CityCode) {
+			allErrs = append(allErrs, field.Duplicate(cityCodePath, cityScaleSettings.CityCode))
+		}
+		cityScaleSettingsCodes.Insert(cityScaleSettings.CityCode)
+
+		allErrs = append(allErrs, validateScaleSettings(cityScaleSettings.ScaleSettings, indexPath.Child("scaleSettings"))...)
+	}
+
+	if placement.ReplicaDistribution != nil {
+		if len(placement.CityScaleSettings) > 0 {
+			allErrs = append(allErrs, field.Forbidden(cityScaleSettingsPath, "may not be provided when replicaDistribution is provided"))
+		}
+
+		if placement.ScaleSettings.MaxReplicas != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("scaleSettings", "maxReplicas"), "may not be provided when replicaDistribution is provided"))
+		}
+
+		allErrs = append(allErrs, validateReplicaDistribution(*placement.ReplicaDistribution, placement, fieldPath.Child("replicaDistribution"), opts)...)
+	}
+
+	for i, override := range placement.TemplateOverrides {
+		allErrs = append(allErrs, validateTemplateOverride(override, placement, fieldPath.Child("templateOverrides").Index(i), opts)...)
+	}
+
+	return allErrs
+}
+
+var supportedReplicaDistributionTypes = sets.New(
+	computev1alpha.EvenReplicaDistributionType,
+	computev1alpha.WeightedReplicaDistributionType,
+)
+
+func validateReplicaDistribution(
+	distribution computev1alpha.ReplicaDistribution,
+	placement computev1alpha.WorkloadPlacement,
+	fieldPath *field.Path,
+	opts WorkloadValidationOptions,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedReplicaDistributionTypes.Has(distribution.Type) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("type"), distribution.Type, sets.List(supportedReplicaDistributionTypes)))
+	}
+
+	weightsPath := fieldPath.Child("weights")
+	if distribution.Type != computev1alpha.WeightedReplicaDistributionType {
+		if len(distribution.Weights) > 0 {
+			allErrs = append(allErrs, field.Forbidden(weightsPath, "may only be provided when type is Weighted"))
+		}
+		return allErrs
+	}
+
+	if len(distribution.Weights) == 0 {
+		allErrs = append(allErrs, field.Required(weightsPath, ""))
+		return allErrs
+	}
+
+	cityCodes := sets.New[string]()
+	totalWeight := int64(0)
+	for i, weight := range distribution.Weights {
+		indexPath := weightsPath.Index(i)
+		cityCodePath := indexPath.Child("cityCode")
+		if !slices.Contains(opts.ValidCityCodes, weight.CityCode) {
+			allErrs = append(allErrs, field.NotSupported(cityCodePath, weight.CityCode, opts.ValidCityCodes))
+		} else if len(placement.CityCodes) > 0 && !slices.Contains(placement.CityCodes, weight.CityCode) {
+			allErrs = append(allErrs, field.Invalid(cityCodePath, weight.CityCode, "must be one of the placement's city codes"))
+		} else if cityCodes.Has(weight.CityCode) {
+			allErrs = append(allErrs, field.Duplicate(cityCodePath, weight.CityCode))
+		}
+		cityCodes.Insert(weight.CityCode)
+
+		if weight.Weight < 0 {
+			allErrs = append(allErrs, field.Invalid(indexPath.Child("weight"), weight.Weight, "must be greater than or equal to 0"))
+		} else {
+			totalWeight += int64(weight.Weight)
+		}
+	}
+
+	if totalWeight == 0 {
+		allErrs = append(allErrs, field.Invalid(weightsPath, totalWeight, "at least one city must have a weight greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateTemplateOverride(
+	override computev1alpha.WorkloadTemplateOverride,
+	placement computev1alpha.WorkloadPlacement,
+	fieldPath *field.Path,
+	opts WorkloadValidationOptions,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	cityCodesPath := fieldPath.Child("cityCodes")
+	for i, cityCode := range override.CityCodes {
+		if !slices.Contains(opts.ValidCityCodes, cityCode) {
+			allErrs = append(allErrs, field.NotSupported(cityCodesPath.Index(i), cityCode, opts.ValidCityCodes))
+		} else if len(placement.CityCodes) > 0 && !slices.Contains(placement.CityCodes, cityCode) {
+			allErrs = append(allErrs, field.Invalid(cityCodesPath.Index(i), cityCode, "must be one of the placement's city codes"))
+		}
+	}
+
+	if len(override.Patch.Raw) == 0 {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("patch"), ""))
+	}
+
+	return allErrs
+}
+
+// validateOverriddenTemplates validates the instance templates which result
+// from applying the placement's template overrides. A template is validated for
+// each city that the overrides are limited to, and for all other cities.
+func validateOverriddenTemplates(
+	placement computev1alpha.WorkloadPlacement,
+	template computev1alpha.InstanceTemplateSpec,
+	fieldPath *field.Path,
+	opts WorkloadValidationOptions,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	overridesPath := fieldPath.Child("templateOverrides")
+
+	// Ensure each override may be applied on its own, so that errors can be
+	// attributed to the override that caused them.
+	for i, override := range placement.TemplateOverrides {
+		override.CityCodes = nil
+		if _, _, err := instancecontrol.ApplyTemplateOverrides(template, []computev1alpha.WorkloadTemplateOverride{override}, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(overridesPath.Index(i).Child("patch"), string(override.Patch.Raw), err.Error()))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	cityCodes := []string{""}
+	for _, override := range placement.TemplateOverrides {
+		for _, cityCode := range override.CityCodes {
+			if !slices.Contains(cityCodes, cityCode) {
+				cityCodes = append(cityCodes, cityCode)
+			}
+		}
+	}
+
+	for _, cityCode := range cityCodes {
+		overriddenTemplate, overridden, err := instancecontrol.ApplyTemplateOverrides(template, placement.TemplateOverrides, cityCode)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(overridesPath, cityCode, err.Error()))
+			continue
+		}
+
+		if !overridden {
+			continue
+		}
+
+		templatePath := overridesPath
+		if cityCode != "" {
+			templatePath = overridesPath.Key(cityCode)
+		}
+		allErrs = append(allErrs, validateInstanceTemplate(overriddenTemplate, templatePath, opts)...)
+	}
+
 	return allErrs
 }
 
@@ -107,6 +553,171 @@ func validateScaleSettings(placement computev1alpha.HorizontalScaleSettings, fie
 			allErrs = append(allErrs, validateScaleSettingMetrics(placement.Metrics, metricsFieldPath)...)
 		}
 	}
+
+	if placement.Behavior != nil {
+		behaviorPath := fieldPath.Child("behavior")
+		if placement.MaxReplicas == nil {
+			allErrs = append(allErrs, field.Forbidden(behaviorPath, "may only be provided when maxReplicas is provided"))
+		}
+
+		if placement.Behavior.ScaleUp != nil {
+			allErrs = append(allErrs, validateScalingRules(*placement.Behavior.ScaleUp, behaviorPath.Child("scaleUp"))...)
+		}
+
+		if placement.Behavior.ScaleDown != nil {
+			allErrs = append(allErrs, validateScalingRules(*placement.Behavior.ScaleDown, behaviorPath.Child("scaleDown"))...)
+		}
+	}
+
+	if placement.UpdateStrategy != nil {
+		allErrs = append(allErrs, validateInstanceUpdateStrategy(*placement.UpdateStrategy, fieldPath.Child("updateStrategy"))...)
+	}
+
+	if placement.AutoHealing != nil {
+		allErrs = append(allErrs, validateAutoHealingPolicy(*placement.AutoHealing, fieldPath.Child("autoHealing"))...)
+	}
+
+	return allErrs
+}
+
+func validateAutoHealingPolicy(policy computev1alpha.AutoHealingPolicy, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.UnhealthyThresholdSeconds != nil && *policy.UnhealthyThresholdSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("unhealthyThresholdSeconds"), *policy.UnhealthyThresholdSeconds, "must be greater than 0"))
+	}
+
+	if policy.InitialBackoffSeconds != nil && *policy.InitialBackoffSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("initialBackoffSeconds"), *policy.InitialBackoffSeconds, "must be greater than 0"))
+	}
+
+	maxBackoffPath := fieldPath.Child("maxBackoffSeconds")
+	if policy.MaxBackoffSeconds != nil {
+		if *policy.MaxBackoffSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(maxBackoffPath, *policy.MaxBackoffSeconds, "must be greater than 0"))
+		} else if policy.InitialBackoffSeconds != nil && *policy.MaxBackoffSeconds < *policy.InitialBackoffSeconds {
+			allErrs = append(allErrs, field.Invalid(maxBackoffPath, *policy.MaxBackoffSeconds, "must be greater than or equal to initialBackoffSeconds"))
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	maxStabilizationWindowSeconds int32 = 3600
+	maxScalingPolicyPeriodSeconds int32 = 1800
+)
+
+var supportedScalingPolicySelects = sets.New(
+	computev1alpha.MaxChangePolicySelect,
+	computev1alpha.MinChangePolicySelect,
+	computev1alpha.DisabledPolicySelect,
+)
+
+var supportedScalingPolicyTypes = sets.New(
+	computev1alpha.InstancesScalingPolicy,
+	computev1alpha.PercentScalingPolicy,
+)
+
+func validateScalingRules(rules computev1alpha.HorizontalScalingRules, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if window := rules.StabilizationWindowSeconds; window != nil && (*window < 0 || *window > maxStabilizationWindowSeconds) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("stabilizationWindowSeconds"), *window, fmt.Sprintf("must be between 0 and %d", maxStabilizationWindowSeconds)))
+	}
+
+	if selectPolicy := rules.SelectPolicy; selectPolicy != nil && !supportedScalingPolicySelects.Has(*selectPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("selectPolicy"), *selectPolicy, sets.List(supportedScalingPolicySelects)))
+	}
+
+	policiesPath := fieldPath.Child("policies")
+	for i, policy := range rules.Policies {
+		policyPath := policiesPath.Index(i)
+
+		if !supportedScalingPolicyTypes.Has(policy.Type) {
+			allErrs = append(allErrs, field.NotSupported(policyPath.Child("type"), policy.Type, sets.List(supportedScalingPolicyTypes)))
+		}
+
+		if policy.Value <= 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("value"), policy.Value, "must be greater than 0"))
+		}
+
+		if policy.PeriodSeconds <= 0 || policy.PeriodSeconds > maxScalingPolicyPeriodSeconds {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("periodSeconds"), policy.PeriodSeconds, fmt.Sprintf("must be between 1 and %d", maxScalingPolicyPeriodSeconds)))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedInstanceUpdateStrategyTypes = sets.New(
+	computev1alpha.RollingUpdateInstanceUpdateStrategyType,
+)
+
+func validateInstanceUpdateStrategy(strategy computev1alpha.InstanceUpdateStrategy, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedInstanceUpdateStrategyTypes.Has(strategy.Type) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("type"), strategy.Type, sets.List(supportedInstanceUpdateStrategyTypes)))
+	}
+
+	rollingUpdate := strategy.RollingUpdate
+	if rollingUpdate == nil {
+		return allErrs
+	}
+
+	rollingUpdatePath := fieldPath.Child("rollingUpdate")
+	if strategy.Type != computev1alpha.RollingUpdateInstanceUpdateStrategyType {
+		allErrs = append(allErrs, field.Forbidden(rollingUpdatePath, "may only be provided when type is RollingUpdate"))
+		return allErrs
+	}
+
+	if rollingUpdate.MaxUnavailable != nil {
+		allErrs = append(allErrs, validateNonNegativeIntOrPercent(*rollingUpdate.MaxUnavailable, rollingUpdatePath.Child("maxUnavailable"))...)
+	}
+
+	if rollingUpdate.MaxSurge != nil {
+		allErrs = append(allErrs, validateNonNegativeIntOrPercent(*rollingUpdate.MaxSurge, rollingUpdatePath.Child("maxSurge"))...)
+	}
+
+	// MaxSurge defaults to 0, so only an explicit MaxUnavailable of 0 needs to
+	// be checked.
+	if rollingUpdate.MaxUnavailable != nil && isZeroIntOrPercent(*rollingUpdate.MaxUnavailable) &&
+		(rollingUpdate.MaxSurge == nil || isZeroIntOrPercent(*rollingUpdate.MaxSurge)) {
+		allErrs = append(allErrs, field.Invalid(rollingUpdatePath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable.String(), "may not be 0 when maxSurge is 0"))
+	}
+
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		allErrs = append(allErrs, field.Invalid(rollingUpdatePath.Child("partition"), *rollingUpdate.Partition, "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
+
+func isZeroIntOrPercent(value intstr.IntOrString) bool {
+	if value.Type == intstr.String {
+		return value.StrVal == "0%"
+	}
+	return value.IntValue() == 0
+}
+
+func validateNonNegativeIntOrPercent(value intstr.IntOrString, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch value.Type {
+	case intstr.Int:
+		if value.IntValue() < 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath, value.IntValue(), "must be greater than or equal to 0"))
+		}
+	case intstr.String:
+		percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+		if !strings.HasSuffix(value.StrVal, "%") || err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath, value.StrVal, "must be an integer or percentage (e.g '5%')"))
+		} else if percent < 0 || percent > 100 {
+			allErrs = append(allErrs, field.Invalid(fieldPath, value.StrVal, "must be a percentage between 0% and 100%"))
+		}
+	}
+
 	return allErrs
 }
 
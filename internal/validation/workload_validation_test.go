@@ -2,7 +2,9 @@ package validation
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -12,12 +14,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
 	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
@@ -61,6 +66,43 @@ func TestValidateWorkloads(t *testing.T) {
 				field.NotSupported(field.NewPath("spec.placements[0].cityCodes[0]"), "TEST", []string{}),
 			},
 		},
+		"placement with location selector": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].CityCodes = []string{}
+					w.Spec.Placements[0].LocationSelector = &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "topology.datum.net/region",
+								Operator: metav1.LabelSelectorOpIn,
+								Values:   []string{"eu-west", "eu-central"},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid location selector": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].LocationSelector = &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "topology.datum.net/region",
+								Operator: metav1.LabelSelectorOpExists,
+								Values:   []string{"eu-west"},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.placements[0].locationSelector.matchExpressions[0].values"), ""),
+			},
+		},
 		"missing placement name": {
 			workload: MakeSandboxWorkload(
 				"test",
@@ -535,193 +577,1279 @@ func TestValidateWorkloads(t *testing.T) {
 				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].ports[1].name"), "", ""),
 			},
 		},
-		"network use denied": {
-			workload: MakeSandboxWorkload("test"),
-			interceptorFuncs: &interceptor.Funcs{
-				Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
-					if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
-						if sar.Spec.ResourceAttributes.Name == "default" &&
-							sar.Spec.ResourceAttributes.Group == networkingv1alpha.GroupVersion.Group &&
-							sar.Spec.ResourceAttributes.Version == networkingv1alpha.GroupVersion.Version &&
-							sar.Spec.ResourceAttributes.Resource == "networks" {
-							sar.Status.Allowed = false
-						}
+		"valid probes": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					container := &w.Spec.Template.Spec.Runtime.Sandbox.Containers[0]
+					container.Ports = []computev1alpha.NamedPort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					}
+					container.ReadinessProbe = &computev1alpha.Probe{
+						ProbeHandler: computev1alpha.ProbeHandler{
+							HTTPGet: &computev1alpha.HTTPGetAction{
+								Path: "/healthz",
+								Port: intstr.FromString("http"),
+								HTTPHeaders: []computev1alpha.HTTPHeader{
+									{Name: "X-Probe", Value: "readiness"},
+								},
+							},
+						},
+					}
+					container.LivenessProbe = &computev1alpha.Probe{
+						ProbeHandler: computev1alpha.ProbeHandler{
+							Exec: &computev1alpha.ExecAction{
+								Command: []string{"/bin/healthcheck"},
+							},
+						},
+						PeriodSeconds: 5,
+					}
+					container.StartupProbe = &computev1alpha.Probe{
+						ProbeHandler: computev1alpha.ProbeHandler{
+							TCPSocket: &computev1alpha.TCPSocketAction{
+								Port: intstr.FromInt32(8080),
+							},
+						},
+						FailureThreshold: 30,
 					}
-					return client.Create(ctx, obj, opts...)
 				},
-			},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid probes": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					container := &w.Spec.Template.Spec.Runtime.Sandbox.Containers[0]
+					container.ReadinessProbe = &computev1alpha.Probe{
+						ProbeHandler: computev1alpha.ProbeHandler{
+							HTTPGet: &computev1alpha.HTTPGetAction{
+								Path:   "healthz",
+								Port:   intstr.FromString("http"),
+								Scheme: "FTP",
+								HTTPHeaders: []computev1alpha.HTTPHeader{
+									{Name: "bad header"},
+								},
+							},
+							TCPSocket: &computev1alpha.TCPSocketAction{
+								Port: intstr.FromInt32(8080),
+							},
+						},
+						TimeoutSeconds: -1,
+					}
+					container.LivenessProbe = &computev1alpha.Probe{
+						ProbeHandler: computev1alpha.ProbeHandler{
+							TCPSocket: &computev1alpha.TCPSocketAction{
+								Port: intstr.FromInt32(0),
+							},
+						},
+						SuccessThreshold: 2,
+					}
+					container.StartupProbe = &computev1alpha.Probe{}
+				},
+			),
 			expectedErrors: field.ErrorList{
-				field.Forbidden(field.NewPath("spec.template.spec.networkInterfaces[0].network"), ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].readinessProbe.httpGet.path"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].readinessProbe.httpGet.port"), "", ""),
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].readinessProbe.httpGet.scheme"), "", []string{}),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].readinessProbe.httpGet.httpHeaders[0].name"), "", ""),
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].readinessProbe.tcpSocket"), ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].readinessProbe.timeoutSeconds"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].livenessProbe.tcpSocket.port"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].livenessProbe.successThreshold"), "", ""),
+				field.Required(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].startupProbe"), ""),
 			},
 		},
-	}
-
-	initObjs := []client.Object{
-		&networkingv1alpha.Network{
-			ObjectMeta: metav1.ObjectMeta{
-				Namespace: "default",
-				Name:      "default",
-			},
+		"valid container command and security context": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					container := &w.Spec.Template.Spec.Runtime.Sandbox.Containers[0]
+					container.Command = []string{"/bin/server"}
+					container.Args = []string{"--port", "8080"}
+					container.WorkingDir = "/srv"
+					container.SecurityContext = &computev1alpha.ContainerSecurityContext{
+						RunAsUser:              ptr.To(int64(1000)),
+						RunAsGroup:             ptr.To(int64(1000)),
+						RunAsNonRoot:           ptr.To(true),
+						ReadOnlyRootFilesystem: ptr.To(true),
+						Capabilities: &computev1alpha.Capabilities{
+							Add:  []computev1alpha.Capability{"NET_BIND_SERVICE"},
+							Drop: []computev1alpha.Capability{"ALL"},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
 		},
-	}
-
-	scheme := k8sruntime.NewScheme()
-	utilruntime.Must(computev1alpha.AddToScheme(scheme))
-	utilruntime.Must(networkingv1alpha.AddToScheme(scheme))
-	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
-
-	fakeClient := fake.NewClientBuilder().
-		WithScheme(scheme).
-		WithInterceptorFuncs(interceptor.Funcs{
-			Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
-				if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
-					// The fake client only allow a resource to be created without a name
-					sar.GenerateName = "sar-"
-				}
-				return client.Create(ctx, obj, opts...)
-			},
-		}).
-		WithObjects(initObjs...).
-		Build()
-
-	for name, scenario := range scenarios {
-		scenario.opts.Context = context.Background()
-		scenario.opts.Workload = scenario.workload
-		c := fakeClient
-
-		if scenario.interceptorFuncs != nil {
-			c = interceptor.NewClient(c, *scenario.interceptorFuncs)
-		}
-
-		scenario.opts.Client = interceptor.NewClient(
-			c,
-			interceptor.Funcs{
-				Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
-					if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
-						sar.Status.Allowed = true
+		"invalid container command and security context": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					container := &w.Spec.Template.Spec.Runtime.Sandbox.Containers[0]
+					container.Command = make([]string, 257)
+					container.Args = []string{strings.Repeat("a", 4097)}
+					container.WorkingDir = "srv"
+					container.SecurityContext = &computev1alpha.ContainerSecurityContext{
+						RunAsUser:    ptr.To(int64(0)),
+						RunAsGroup:   ptr.To(int64(-1)),
+						RunAsNonRoot: ptr.To(true),
+						Capabilities: &computev1alpha.Capabilities{
+							Add:  []computev1alpha.Capability{"SYS_ADMIN", "CHOWN", "CHOWN"},
+							Drop: []computev1alpha.Capability{""},
+						},
 					}
-					return client.Create(ctx, obj, opts...)
 				},
+			),
+			expectedErrors: field.ErrorList{
+				field.TooMany(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].command"), 0, 0),
+				field.TooLong(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].args[0]"), "", 0),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].workingDir"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].securityContext.runAsUser"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].securityContext.runAsGroup"), "", ""),
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].securityContext.capabilities.add[0]"), "", []string{}),
+				field.Duplicate(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].securityContext.capabilities.add[2]"), ""),
+				field.Required(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].securityContext.capabilities.drop[0]"), ""),
 			},
-		)
-
-		if len(scenario.opts.ValidCityCodes) == 0 {
-			scenario.opts.ValidCityCodes = []string{"DFW"}
-		}
-
-		t.Run(name, func(t *testing.T) {
-			errs := ValidateWorkloadCreate(scenario.workload, scenario.opts)
-
-			delta := cmp.Diff(scenario.expectedErrors, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail"))
-			if delta != "" {
-				t.Errorf("Testcase %s - expected errors '%v', got '%v', diff: '%v'", name, scenario.expectedErrors, errs, delta)
-			}
-		})
-	}
-}
-
-// Inspired by https://github.com/kubernetes/kubernetes/blob/79cca2786e037d8c8ae7fe856c5ae158b100ce71/pkg/api/pod/testing/make.go
-
-type Tweak func(*computev1alpha.Workload)
-
-// MakeSandboxWorkload returns a sandbox runtime workload that will pass
-// validation. By default this produces a workload with a single container and
-// single placement.
-func MakeSandboxWorkload(name string, tweaks ...Tweak) *computev1alpha.Workload {
-	workload := &computev1alpha.Workload{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: metav1.NamespaceDefault,
 		},
-		Spec: computev1alpha.WorkloadSpec{
-			Template: computev1alpha.InstanceTemplateSpec{
-				Spec: computev1alpha.InstanceSpec{
-					NetworkInterfaces: []computev1alpha.InstanceNetworkInterface{
+		"valid init containers": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Volumes = []computev1alpha.InstanceVolume{
 						{
-							Network: networkingv1alpha.NetworkRef{
-								Name: "default",
+							Name: "config",
+							VolumeSource: computev1alpha.VolumeSource{
+								ConfigMap: &k8scorev1.ConfigMapVolumeSource{
+									LocalObjectReference: k8scorev1.LocalObjectReference{Name: "config"},
+								},
 							},
 						},
-					},
-					Runtime: computev1alpha.InstanceRuntimeSpec{
-						Resources: computev1alpha.InstanceRuntimeResources{
-							InstanceType: "datumcloud/d1-standard-2",
+					}
+					w.Spec.Template.Spec.Runtime.Sandbox.InitContainers = []computev1alpha.SandboxContainer{
+						{
+							Name:          "proxy",
+							Image:         "registry.tld/proxy:v1",
+							RestartPolicy: ptr.To(computev1alpha.AlwaysContainerRestartPolicy),
+							ReadinessProbe: &computev1alpha.Probe{
+								ProbeHandler: computev1alpha.ProbeHandler{
+									TCPSocket: &computev1alpha.TCPSocketAction{
+										Port: intstr.FromInt32(15000),
+									},
+								},
+							},
 						},
-						Sandbox: &computev1alpha.SandboxRuntime{
-							Containers: []computev1alpha.SandboxContainer{
+						{
+							Name:  "migrate",
+							Image: "registry.tld/migrate:v1",
+							VolumeAttachments: []computev1alpha.VolumeAttachment{
 								{
-									Name:  "container1",
-									Image: "registry.tld/image:tag",
+									Name:      "config",
+									MountPath: proto.String("/config"),
 								},
 							},
 						},
-					},
-				},
-			},
-			Placements: []computev1alpha.WorkloadPlacement{
-				{
-					Name:      "placement1",
-					CityCodes: []string{"DFW"},
-					ScaleSettings: computev1alpha.HorizontalScaleSettings{
-						MinReplicas: 1,
-					},
+					}
 				},
-			},
-		},
-	}
-
-	for _, tweak := range tweaks {
-		tweak(workload)
-	}
-
-	return workload
-}
-
-// MakeVMWorkload returns a VM runtime workload that will pass validation. By
-// default this produces a workload single placement.
-func MakeVMWorkload(name string, tweaks ...Tweak) *computev1alpha.Workload {
-	workload := &computev1alpha.Workload{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: metav1.NamespaceDefault,
+			),
+			expectedErrors: field.ErrorList{},
 		},
-		Spec: computev1alpha.WorkloadSpec{
-			Template: computev1alpha.InstanceTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						computev1alpha.SSHKeysAnnotation: "user:ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILPbDbsv9fgEnam9iJ5b51Na/WieeiKCJRC0+m7fRwPk vscode@42aafaf8293e",
-					},
-				},
-				Spec: computev1alpha.InstanceSpec{
-					NetworkInterfaces: []computev1alpha.InstanceNetworkInterface{
+		"invalid init containers": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].RestartPolicy = ptr.To(computev1alpha.AlwaysContainerRestartPolicy)
+					w.Spec.Template.Spec.Runtime.Sandbox.InitContainers = []computev1alpha.SandboxContainer{
 						{
-							Network: networkingv1alpha.NetworkRef{
-								Name: "default",
+							Name:  w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Name,
+							Image: "registry.tld/migrate:v1",
+							ReadinessProbe: &computev1alpha.Probe{
+								ProbeHandler: computev1alpha.ProbeHandler{
+									TCPSocket: &computev1alpha.TCPSocketAction{
+										Port: intstr.FromInt32(8080),
+									},
+								},
 							},
 						},
-					},
-					Runtime: computev1alpha.InstanceRuntimeSpec{
-						Resources: computev1alpha.InstanceRuntimeResources{
-							InstanceType: "datumcloud/d1-standard-2",
-						},
-						VirtualMachine: &computev1alpha.VirtualMachineRuntime{
+						{
+							Name:          "proxy",
+							RestartPolicy: ptr.To(computev1alpha.ContainerRestartPolicy("OnFailure")),
 							VolumeAttachments: []computev1alpha.VolumeAttachment{
 								{
-									Name: "boot",
+									Name: "missing-volume",
 								},
 							},
 						},
-					},
-					Volumes: []computev1alpha.InstanceVolume{
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].restartPolicy"), ""),
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox.initContainers[0].readinessProbe"), ""),
+				field.Duplicate(field.NewPath("spec.template.spec.runtime.sandbox.initContainers[0].name"), ""),
+				field.Required(field.NewPath("spec.template.spec.runtime.sandbox.initContainers[1].image"), ""),
+				field.NotFound(field.NewPath("spec.template.spec.runtime.sandbox.initContainers[1].volumeAttachments[0].name"), ""),
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.sandbox.initContainers[1].restartPolicy"), "", []string{}),
+			},
+		},
+		"valid env": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Env = []computev1alpha.EnvVar{
 						{
-							Name: "boot",
-							VolumeSource: computev1alpha.VolumeSource{
-								Disk: &computev1alpha.DiskTemplateVolumeSource{
-									Template: &computev1alpha.DiskTemplateVolumeSourceTemplate{
-										Spec: computev1alpha.DiskSpec{
-											Type: "pd-standard",
-											Populator: &computev1alpha.DiskPopulator{
-												Image: &computev1alpha.ImageDiskPopulator{
+							Name:  "LOG_LEVEL",
+							Value: "debug",
+						},
+						{
+							Name: "DATABASE_PASSWORD",
+							ValueFrom: &computev1alpha.EnvVarSource{
+								SecretKeyRef: &computev1alpha.SecretKeySelector{
+									Name: "database",
+									Key:  "password",
+								},
+							},
+						},
+						{
+							Name: "FEATURE_FLAGS",
+							ValueFrom: &computev1alpha.EnvVarSource{
+								ConfigMapKeyRef: &computev1alpha.ConfigMapKeySelector{
+									Name:     "features",
+									Key:      "flags.json",
+									Optional: ptr.To(true),
+								},
+							},
+						},
+						{
+							Name: "CITY_CODE",
+							ValueFrom: &computev1alpha.EnvVarSource{
+								InstanceFieldRef: &computev1alpha.InstanceFieldSelector{
+									FieldPath: computev1alpha.InstanceCityCodeFieldPath,
+								},
+							},
+						},
+						{
+							Name: "NETWORK_IP",
+							ValueFrom: &computev1alpha.EnvVarSource{
+								InstanceFieldRef: &computev1alpha.InstanceFieldSelector{
+									FieldPath:             computev1alpha.InstanceNetworkIPFieldPath,
+									NetworkInterfaceIndex: ptr.To(int32(0)),
+								},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid env": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Env = []computev1alpha.EnvVar{
+						{
+							Name:  "1INVALID=",
+							Value: "value",
+						},
+						{
+							Name:  "BOTH",
+							Value: "value",
+							ValueFrom: &computev1alpha.EnvVarSource{
+								SecretKeyRef: &computev1alpha.SecretKeySelector{
+									Name: "database",
+									Key:  "bad/key",
+								},
+								ConfigMapKeyRef: &computev1alpha.ConfigMapKeySelector{
+									Name: "features",
+									Key:  "flags",
+								},
+							},
+						},
+						{
+							Name:      "EMPTY",
+							ValueFrom: &computev1alpha.EnvVarSource{},
+						},
+						{
+							Name: "FIELD",
+							ValueFrom: &computev1alpha.EnvVarSource{
+								InstanceFieldRef: &computev1alpha.InstanceFieldSelector{
+									FieldPath:             "spec.nodeName",
+									NetworkInterfaceIndex: ptr.To(int32(1)),
+								},
+							},
+						},
+						{
+							Name:  "BOTH",
+							Value: "value",
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[0].name"), "", ""),
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[1].valueFrom"), ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[1].valueFrom.secretKeyRef.key"), "", ""),
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[1].valueFrom.configMapKeyRef"), ""),
+				field.Required(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[2].valueFrom"), ""),
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[3].valueFrom.instanceFieldRef.fieldPath"), "", []string{}),
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[3].valueFrom.instanceFieldRef.networkInterfaceIndex"), ""),
+				field.Duplicate(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].env[4].name"), ""),
+			},
+		},
+		"valid container resources": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					sandbox := w.Spec.Template.Spec.Runtime.Sandbox
+					sandbox.Containers[0].Resources = &computev1alpha.ContainerResourceRequirements{
+						Requests: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:    resource.MustParse("1"),
+							k8scorev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Limits: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:    resource.MustParse("1500m"),
+							k8scorev1.ResourceMemory: resource.MustParse("1536Mi"),
+						},
+					}
+					sandbox.Containers = append(sandbox.Containers, computev1alpha.SandboxContainer{
+						Name:  "sidecar",
+						Image: "registry.tld/sidecar:tag",
+						Resources: &computev1alpha.ContainerResourceRequirements{
+							Requests: k8scorev1.ResourceList{
+								k8scorev1.ResourceCPU:    resource.MustParse("100m"),
+								k8scorev1.ResourceMemory: resource.MustParse("256Mi"),
+							},
+							Limits: k8scorev1.ResourceList{
+								k8scorev1.ResourceCPU:    resource.MustParse("500m"),
+								k8scorev1.ResourceMemory: resource.MustParse("512Mi"),
+							},
+						},
+					})
+					sandbox.InitContainers = []computev1alpha.SandboxContainer{
+						{
+							Name:  "migrate",
+							Image: "registry.tld/migrate:tag",
+							Resources: &computev1alpha.ContainerResourceRequirements{
+								Limits: k8scorev1.ResourceList{
+									k8scorev1.ResourceCPU: resource.MustParse("2"),
+								},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid container resources": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					sandbox := w.Spec.Template.Spec.Runtime.Sandbox
+					sandbox.Containers[0].Resources = &computev1alpha.ContainerResourceRequirements{
+						Requests: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:    resource.MustParse("2"),
+							k8scorev1.ResourceMemory: resource.MustParse("0"),
+						},
+						Limits: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU: resource.MustParse("1"),
+							"datumcloud.com/gpu":  resource.MustParse("1"),
+						},
+					}
+					sandbox.Containers = append(sandbox.Containers, computev1alpha.SandboxContainer{
+						Name:  "sidecar",
+						Image: "registry.tld/sidecar:tag",
+						Resources: &computev1alpha.ContainerResourceRequirements{
+							Requests: k8scorev1.ResourceList{
+								k8scorev1.ResourceCPU: resource.MustParse("500m"),
+							},
+							Limits: k8scorev1.ResourceList{
+								k8scorev1.ResourceCPU: resource.MustParse("500m"),
+							},
+						},
+					})
+					sandbox.InitContainers = []computev1alpha.SandboxContainer{
+						{
+							Name:  "migrate",
+							Image: "registry.tld/migrate:tag",
+							Resources: &computev1alpha.ContainerResourceRequirements{
+								Requests: k8scorev1.ResourceList{
+									k8scorev1.ResourceMemory: resource.MustParse("4Gi"),
+								},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].resources.requests[cpu]"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].resources.requests[memory]"), "", ""),
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.sandbox.containers[0].resources.limits[datumcloud.com/gpu]"), "", []string{}),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.initContainers[0].resources.requests[memory]"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers"), "", ""),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.sandbox.containers"), "", ""),
+			},
+		},
+		"custom instance type": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Resources = computev1alpha.InstanceRuntimeResources{
+						InstanceType: "datumcloud/d1-custom",
+						Requests: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:    resource.MustParse("4"),
+							k8scorev1.ResourceMemory: resource.MustParse("8Gi"),
+						},
+					}
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Resources = &computev1alpha.ContainerResourceRequirements{
+						Requests: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:    resource.MustParse("4"),
+							k8scorev1.ResourceMemory: resource.MustParse("8Gi"),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid custom instance type requests": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Resources = computev1alpha.InstanceRuntimeResources{
+						InstanceType: "datumcloud/d1-custom",
+						Requests: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:              resource.MustParse("16"),
+							k8scorev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.resources.requests[ephemeral-storage]"), "", []string{}),
+				field.Invalid(field.NewPath("spec.template.spec.runtime.resources.requests[cpu]"), "", ""),
+				field.Required(field.NewPath("spec.template.spec.runtime.resources.requests[memory]"), ""),
+			},
+		},
+		"custom instance type request increment": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Resources = computev1alpha.InstanceRuntimeResources{
+						InstanceType: "datumcloud/d1-custom",
+						Requests: k8scorev1.ResourceList{
+							k8scorev1.ResourceCPU:    resource.MustParse("1500m"),
+							k8scorev1.ResourceMemory: resource.MustParse("2Gi"),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.template.spec.runtime.resources.requests[cpu]"), "", ""),
+			},
+		},
+		"requests for instance type without customization": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Resources.Requests = k8scorev1.ResourceList{
+						k8scorev1.ResourceCPU: resource.MustParse("4"),
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.resources.requests"), ""),
+			},
+		},
+		"runtime not supported by instance type": {
+			workload: MakeSandboxWorkload("test"),
+			opts: WorkloadValidationOptions{
+				InstanceTypes: []computev1alpha.InstanceType{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "datumcloud.d1-standard-2",
+						},
+						Spec: computev1alpha.InstanceTypeSpec{
+							Resources: k8scorev1.ResourceList{
+								k8scorev1.ResourceCPU:    resource.MustParse("2"),
+								k8scorev1.ResourceMemory: resource.MustParse("2Gi"),
+							},
+							Runtimes: []computev1alpha.InstanceRuntimeType{
+								computev1alpha.VirtualMachineInstanceRuntimeType,
+							},
+						},
+					},
+				},
+			},
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.sandbox"), ""),
+			},
+		},
+		"vm exec probe": {
+			workload: MakeVMWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.VirtualMachine.ReadinessProbe = &computev1alpha.Probe{
+						ProbeHandler: computev1alpha.ProbeHandler{
+							Exec: &computev1alpha.ExecAction{
+								Command: []string{"/bin/true"},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.template.spec.runtime.virtualMachine.readinessProbe.exec"), ""),
+			},
+		},
+		"valid gateway": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Ports = []computev1alpha.NamedPort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					}
+					w.Spec.Gateway = makeWorkloadGateway()
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"gateway unsupported listener protocol": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Ports = []computev1alpha.NamedPort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					}
+					w.Spec.Gateway = makeWorkloadGateway()
+					w.Spec.Gateway.Template.Spec.Listeners[0].Protocol = gatewayv1.HTTPProtocolType
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.gateway.template.spec.listeners[0].protocol"), "", []string{}),
+			},
+		},
+		"gateway invalid route references": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Ports = []computev1alpha.NamedPort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					}
+					w.Spec.Gateway = makeWorkloadGateway()
+					tcpRoute := &w.Spec.Gateway.TCPRoutes[0]
+					tcpRoute.ParentRefs[0].Name = "other-gateway"
+					tcpRoute.ParentRefs[0].SectionName = ptr.To(gatewayv1.SectionName("missing"))
+					tcpRoute.Rules[0].BackendRefs[0].Namespace = ptr.To(gatewayv1.Namespace("other"))
+					tcpRoute.Rules[0].BackendRefs[0].Port = ptr.To(gatewayv1.PortNumber(9090))
+					tcpRoute.Rules[0].BackendRefs = append(tcpRoute.Rules[0].BackendRefs, gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Kind: ptr.To(gatewayv1.Kind("Service")),
+							Name: "missing",
+						},
+					})
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.gateway.tcpRoutes[0].parentRefs[0].name"), "", []string{}),
+				field.NotFound(field.NewPath("spec.gateway.tcpRoutes[0].parentRefs[0].sectionName"), ""),
+				field.Forbidden(field.NewPath("spec.gateway.tcpRoutes[0].rules[0].backendRefs[0].namespace"), ""),
+				field.Invalid(field.NewPath("spec.gateway.tcpRoutes[0].rules[0].backendRefs[0].port"), "", ""),
+				field.NotSupported(field.NewPath("spec.gateway.tcpRoutes[0].rules[0].backendRefs[1].group"), "", []string{}),
+				field.NotSupported(field.NewPath("spec.gateway.tcpRoutes[0].rules[0].backendRefs[1].kind"), "", []string{}),
+				field.NotFound(field.NewPath("spec.gateway.tcpRoutes[0].rules[0].backendRefs[1].name"), ""),
+			},
+		},
+		"gateway invalid route names": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Template.Spec.Runtime.Sandbox.Containers[0].Ports = []computev1alpha.NamedPort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					}
+					w.Spec.Gateway = makeWorkloadGateway()
+					w.Spec.Gateway.TCPRoutes = append(w.Spec.Gateway.TCPRoutes, *w.Spec.Gateway.TCPRoutes[0].DeepCopy())
+
+					invalidName := *w.Spec.Gateway.TCPRoutes[0].DeepCopy()
+					invalidName.Name = "Invalid_Name"
+					missingName := *w.Spec.Gateway.TCPRoutes[0].DeepCopy()
+					missingName.Name = ""
+					w.Spec.Gateway.TCPRoutes = append(w.Spec.Gateway.TCPRoutes, invalidName, missingName)
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Duplicate(field.NewPath("spec.gateway.tcpRoutes[1].name"), ""),
+				field.Invalid(field.NewPath("spec.gateway.tcpRoutes[2].name"), "", ""),
+				field.Required(field.NewPath("spec.gateway.tcpRoutes[3].name"), ""),
+			},
+		},
+		"valid rolling update strategy": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ScaleSettings.UpdateStrategy = &computev1alpha.InstanceUpdateStrategy{
+						Type: computev1alpha.RollingUpdateInstanceUpdateStrategyType,
+						RollingUpdate: &computev1alpha.RollingUpdateInstanceStrategy{
+							MaxUnavailable: ptr.To(intstr.FromString("25%")),
+							MaxSurge:       ptr.To(intstr.FromInt32(1)),
+							Partition:      ptr.To(int32(1)),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid rolling update strategy": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ScaleSettings.UpdateStrategy = &computev1alpha.InstanceUpdateStrategy{
+						Type: computev1alpha.RollingUpdateInstanceUpdateStrategyType,
+						RollingUpdate: &computev1alpha.RollingUpdateInstanceStrategy{
+							MaxUnavailable: ptr.To(intstr.FromString("0%")),
+							MaxSurge:       ptr.To(intstr.FromString("150%")),
+							Partition:      ptr.To(int32(-1)),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.updateStrategy.rollingUpdate.maxSurge"), "", ""),
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.updateStrategy.rollingUpdate.partition"), "", ""),
+			},
+		},
+		"rolling update strategy without surge or unavailability": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ScaleSettings.UpdateStrategy = &computev1alpha.InstanceUpdateStrategy{
+						Type: computev1alpha.RollingUpdateInstanceUpdateStrategyType,
+						RollingUpdate: &computev1alpha.RollingUpdateInstanceStrategy{
+							MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.updateStrategy.rollingUpdate.maxUnavailable"), "", ""),
+			},
+		},
+		"invalid auto healing policy": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ScaleSettings.AutoHealing = &computev1alpha.AutoHealingPolicy{
+						UnhealthyThresholdSeconds: ptr.To(int32(0)),
+						InitialBackoffSeconds:     ptr.To(int32(120)),
+						MaxBackoffSeconds:         ptr.To(int32(60)),
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.autoHealing.unhealthyThresholdSeconds"), "", ""),
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.autoHealing.maxBackoffSeconds"), "", ""),
+			},
+		},
+		"invalid scaling behavior": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ScaleSettings.Behavior = &computev1alpha.HorizontalScalingBehavior{
+						ScaleUp: &computev1alpha.HorizontalScalingRules{
+							StabilizationWindowSeconds: ptr.To(int32(7200)),
+							Policies: []computev1alpha.HorizontalScalingPolicy{
+								{
+									Type:          "Pods",
+									Value:         0,
+									PeriodSeconds: 3600,
+								},
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.placements[0].scaleSettings.behavior"), ""),
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.behavior.scaleUp.stabilizationWindowSeconds"), "", ""),
+				field.NotSupported(field.NewPath("spec.placements[0].scaleSettings.behavior.scaleUp.policies[0].type"), "", []string{}),
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.behavior.scaleUp.policies[0].value"), "", ""),
+				field.Invalid(field.NewPath("spec.placements[0].scaleSettings.behavior.scaleUp.policies[0].periodSeconds"), "", ""),
+			},
+		},
+		"invalid revision history": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.RevisionHistoryLimit = ptr.To(int32(-1))
+					w.Spec.RollbackTo = &computev1alpha.WorkloadRollbackConfig{
+						Revision: -1,
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.revisionHistoryLimit"), "", ""),
+				field.Invalid(field.NewPath("spec.rollbackTo.revision"), "", ""),
+			},
+		},
+		"valid progressive rollout strategy": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.RolloutStrategy = &computev1alpha.WorkloadRolloutStrategy{
+						Type: computev1alpha.ProgressiveWorkloadRolloutStrategyType,
+						Progressive: &computev1alpha.ProgressiveRolloutStrategy{
+							WaveBy:                  computev1alpha.PlacementRolloutWaveType,
+							SoakSeconds:             ptr.To(int32(0)),
+							ProgressDeadlineSeconds: ptr.To(int32(900)),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid progressive rollout strategy": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.RolloutStrategy = &computev1alpha.WorkloadRolloutStrategy{
+						Type: computev1alpha.ProgressiveWorkloadRolloutStrategyType,
+						Progressive: &computev1alpha.ProgressiveRolloutStrategy{
+							WaveBy:                  "Region",
+							SoakSeconds:             ptr.To(int32(-1)),
+							ProgressDeadlineSeconds: ptr.To(int32(0)),
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.rolloutStrategy.progressive.waveBy"), "", []string{}),
+				field.Invalid(field.NewPath("spec.rolloutStrategy.progressive.soakSeconds"), "", ""),
+				field.Invalid(field.NewPath("spec.rolloutStrategy.progressive.progressDeadlineSeconds"), "", ""),
+			},
+		},
+		"progressive settings with all at once rollout strategy": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.RolloutStrategy = &computev1alpha.WorkloadRolloutStrategy{
+						Type:        computev1alpha.AllAtOnceWorkloadRolloutStrategyType,
+						Progressive: &computev1alpha.ProgressiveRolloutStrategy{},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.rolloutStrategy.progressive"), ""),
+			},
+		},
+		"valid city scale settings": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].CityCodes = []string{"DFW", "LHR"}
+					w.Spec.Placements[0].CityScaleSettings = []computev1alpha.CityScaleSettings{
+						{
+							CityCode: "DFW",
+							ScaleSettings: computev1alpha.HorizontalScaleSettings{
+								MinReplicas: 10,
+							},
+						},
+					}
+				},
+			),
+			opts: WorkloadValidationOptions{
+				ValidCityCodes: []string{"DFW", "LHR"},
+			},
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid city scale settings": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].CityScaleSettings = []computev1alpha.CityScaleSettings{
+						{
+							CityCode: "TEST",
+							ScaleSettings: computev1alpha.HorizontalScaleSettings{
+								MinReplicas: 1,
+							},
+						},
+						{
+							CityCode: "LHR",
+							ScaleSettings: computev1alpha.HorizontalScaleSettings{
+								MinReplicas: 1,
+							},
+						},
+						{
+							CityCode: "DFW",
+							ScaleSettings: computev1alpha.HorizontalScaleSettings{
+								MinReplicas: 0,
+							},
+						},
+						{
+							CityCode: "DFW",
+							ScaleSettings: computev1alpha.HorizontalScaleSettings{
+								MinReplicas: 1,
+							},
+						},
+					}
+					w.Spec.Placements[0].ReplicaDistribution = &computev1alpha.ReplicaDistribution{
+						Type: computev1alpha.EvenReplicaDistributionType,
+					}
+				},
+			),
+			opts: WorkloadValidationOptions{
+				ValidCityCodes: []string{"DFW", "LHR"},
+			},
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.placements[0].cityScaleSettings[0].cityCode"), "TEST", []string{}),
+				field.Invalid(field.NewPath("spec.placements[0].cityScaleSettings[1].cityCode"), "LHR", ""),
+				field.Invalid(field.NewPath("spec.placements[0].cityScaleSettings[2].scaleSettings.minReplicas"), 0, ""),
+				field.Duplicate(field.NewPath("spec.placements[0].cityScaleSettings[3].cityCode"), "DFW"),
+				field.Forbidden(field.NewPath("spec.placements[0].cityScaleSettings"), ""),
+			},
+		},
+		"valid replica distribution": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].CityCodes = []string{"DFW", "LHR"}
+					w.Spec.Placements[0].ReplicaDistribution = &computev1alpha.ReplicaDistribution{
+						Type: computev1alpha.WeightedReplicaDistributionType,
+						Weights: []computev1alpha.CityReplicaWeight{
+							{CityCode: "DFW", Weight: 2},
+							{CityCode: "LHR", Weight: 1},
+						},
+					}
+				},
+			),
+			opts: WorkloadValidationOptions{
+				ValidCityCodes: []string{"DFW", "LHR"},
+			},
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid replica distribution": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ScaleSettings.MaxReplicas = proto.Int32(10)
+					w.Spec.Placements[0].ScaleSettings.Metrics = []computev1alpha.MetricSpec{
+						{
+							Resource: &computev1alpha.ResourceMetricSource{
+								Name: "cpu",
+								Target: computev1alpha.MetricTarget{
+									AverageUtilization: proto.Int32(50),
+								},
+							},
+						},
+					}
+					w.Spec.Placements[0].ReplicaDistribution = &computev1alpha.ReplicaDistribution{
+						Type: computev1alpha.WeightedReplicaDistributionType,
+						Weights: []computev1alpha.CityReplicaWeight{
+							{CityCode: "TEST", Weight: 1},
+							{CityCode: "LHR", Weight: 1},
+							{CityCode: "DFW", Weight: -1},
+							{CityCode: "DFW", Weight: 0},
+						},
+					}
+				},
+			),
+			opts: WorkloadValidationOptions{
+				ValidCityCodes: []string{"DFW", "LHR"},
+			},
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.placements[0].scaleSettings.maxReplicas"), ""),
+				field.NotSupported(field.NewPath("spec.placements[0].replicaDistribution.weights[0].cityCode"), "TEST", []string{}),
+				field.Invalid(field.NewPath("spec.placements[0].replicaDistribution.weights[1].cityCode"), "LHR", ""),
+				field.Invalid(field.NewPath("spec.placements[0].replicaDistribution.weights[2].weight"), -1, ""),
+				field.Duplicate(field.NewPath("spec.placements[0].replicaDistribution.weights[3].cityCode"), "DFW"),
+			},
+		},
+		"even replica distribution with weights": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].ReplicaDistribution = &computev1alpha.ReplicaDistribution{
+						Type: computev1alpha.EvenReplicaDistributionType,
+						Weights: []computev1alpha.CityReplicaWeight{
+							{CityCode: "DFW", Weight: 1},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.placements[0].replicaDistribution.weights"), ""),
+			},
+		},
+		"valid template overrides": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].TemplateOverrides = []computev1alpha.WorkloadTemplateOverride{
+						{
+							Patch: k8sruntime.RawExtension{
+								Raw: []byte(`{"spec":{"runtime":{"sandbox":{"containers":[{"name":"container1","env":[{"name":"REGION","value":"us"}]}]}}}}`),
+							},
+						},
+						{
+							CityCodes: []string{"DFW"},
+							Patch: k8sruntime.RawExtension{
+								Raw: []byte(`{"spec":{"runtime":{"sandbox":{"containers":[{"name":"container1","image":"registry.tld/image:dfw"}]}}}}`),
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"invalid template overrides": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].TemplateOverrides = []computev1alpha.WorkloadTemplateOverride{
+						{
+							CityCodes: []string{"TEST", "LHR"},
+						},
+					}
+				},
+			),
+			opts: WorkloadValidationOptions{
+				ValidCityCodes: []string{"DFW", "LHR"},
+			},
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.placements[0].templateOverrides[0].cityCodes[0]"), "TEST", []string{}),
+				field.Invalid(field.NewPath("spec.placements[0].templateOverrides[0].cityCodes[1]"), "LHR", ""),
+				field.Required(field.NewPath("spec.placements[0].templateOverrides[0].patch"), ""),
+			},
+		},
+		"template override with unknown field": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].TemplateOverrides = []computev1alpha.WorkloadTemplateOverride{
+						{
+							Patch: k8sruntime.RawExtension{
+								Raw: []byte(`{"spec":{"unknown":true}}`),
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.placements[0].templateOverrides[0].patch"), "", ""),
+			},
+		},
+		"template override results in invalid template": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Placements[0].TemplateOverrides = []computev1alpha.WorkloadTemplateOverride{
+						{
+							CityCodes: []string{"DFW"},
+							Patch: k8sruntime.RawExtension{
+								Raw: []byte(`{"spec":{"runtime":{"resources":{"instanceType":"datumcloud/unknown"}}}}`),
+							},
+						},
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.placements[0].templateOverrides[DFW].spec.runtime.resources"), "", []string{}),
+			},
+		},
+		"no declared instance types": {
+			workload: MakeSandboxWorkload("test"),
+			opts: WorkloadValidationOptions{
+				InstanceTypes: []computev1alpha.InstanceType{},
+			},
+			expectedErrors: field.ErrorList{
+				field.NotSupported(field.NewPath("spec.template.spec.runtime.resources"), "", []string{}),
+			},
+		},
+		"invalid restart request": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Restart = &computev1alpha.InstanceRestartRequest{
+						Type: "Reboot",
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Required(field.NewPath("spec.restart.requestedAt"), ""),
+				field.NotSupported(field.NewPath("spec.restart.type"), "Reboot", []string{}),
+			},
+		},
+		"restart requested in the future": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Restart = &computev1alpha.InstanceRestartRequest{
+						RequestedAt: metav1.NewTime(time.Now().Add(time.Hour)),
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{
+				field.Invalid(field.NewPath("spec.restart.requestedAt"), "", ""),
+			},
+		},
+		"restart requested within clock skew": {
+			workload: MakeSandboxWorkload(
+				"test",
+				func(w *computev1alpha.Workload) {
+					w.Spec.Restart = &computev1alpha.InstanceRestartRequest{
+						RequestedAt: metav1.NewTime(time.Now().Add(time.Minute)),
+					}
+				},
+			),
+			expectedErrors: field.ErrorList{},
+		},
+		"network use denied": {
+			workload: MakeSandboxWorkload("test"),
+			interceptorFuncs: &interceptor.Funcs{
+				Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+						if sar.Spec.ResourceAttributes.Name == "default" &&
+							sar.Spec.ResourceAttributes.Group == networkingv1alpha.GroupVersion.Group &&
+							sar.Spec.ResourceAttributes.Version == networkingv1alpha.GroupVersion.Version &&
+							sar.Spec.ResourceAttributes.Resource == "networks" {
+							sar.Status.Allowed = false
+						}
+					}
+					return client.Create(ctx, obj, opts...)
+				},
+			},
+			expectedErrors: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.template.spec.networkInterfaces[0].network"), ""),
+			},
+		},
+	}
+
+	initObjs := []client.Object{
+		&networkingv1alpha.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "default",
+			},
+		},
+	}
+
+	scheme := k8sruntime.NewScheme()
+	utilruntime.Must(computev1alpha.AddToScheme(scheme))
+	utilruntime.Must(networkingv1alpha.AddToScheme(scheme))
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+					// The fake client only allow a resource to be created without a name
+					sar.GenerateName = "sar-"
+				}
+				return client.Create(ctx, obj, opts...)
+			},
+		}).
+		WithObjects(initObjs...).
+		Build()
+
+	for name, scenario := range scenarios {
+		scenario.opts.Context = context.Background()
+		scenario.opts.Workload = scenario.workload
+		c := fakeClient
+
+		if scenario.interceptorFuncs != nil {
+			c = interceptor.NewClient(c, *scenario.interceptorFuncs)
+		}
+
+		scenario.opts.Client = interceptor.NewClient(
+			c,
+			interceptor.Funcs{
+				Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+						sar.Status.Allowed = true
+					}
+					return client.Create(ctx, obj, opts...)
+				},
+			},
+		)
+
+		if len(scenario.opts.ValidCityCodes) == 0 {
+			scenario.opts.ValidCityCodes = []string{"DFW"}
+		}
+
+		if scenario.opts.InstanceTypes == nil {
+			scenario.opts.InstanceTypes = makeInstanceTypes()
+		}
+
+		t.Run(name, func(t *testing.T) {
+			errs := ValidateWorkloadCreate(scenario.workload, scenario.opts)
+
+			delta := cmp.Diff(scenario.expectedErrors, errs, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail"))
+			if delta != "" {
+				t.Errorf("Testcase %s - expected errors '%v', got '%v', diff: '%v'", name, scenario.expectedErrors, errs, delta)
+			}
+		})
+	}
+}
+
+// Inspired by https://github.com/kubernetes/kubernetes/blob/79cca2786e037d8c8ae7fe856c5ae158b100ce71/pkg/api/pod/testing/make.go
+
+type Tweak func(*computev1alpha.Workload)
+
+// MakeSandboxWorkload returns a sandbox runtime workload that will pass
+// validation. By default this produces a workload with a single container and
+// single placement.
+func MakeSandboxWorkload(name string, tweaks ...Tweak) *computev1alpha.Workload {
+	workload := &computev1alpha.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: computev1alpha.WorkloadSpec{
+			Template: computev1alpha.InstanceTemplateSpec{
+				Spec: computev1alpha.InstanceSpec{
+					NetworkInterfaces: []computev1alpha.InstanceNetworkInterface{
+						{
+							Network: networkingv1alpha.NetworkRef{
+								Name: "default",
+							},
+						},
+					},
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Resources: computev1alpha.InstanceRuntimeResources{
+							InstanceType: "datumcloud/d1-standard-2",
+						},
+						Sandbox: &computev1alpha.SandboxRuntime{
+							Containers: []computev1alpha.SandboxContainer{
+								{
+									Name:  "container1",
+									Image: "registry.tld/image:tag",
+								},
+							},
+						},
+					},
+				},
+			},
+			Placements: []computev1alpha.WorkloadPlacement{
+				{
+					Name:      "placement1",
+					CityCodes: []string{"DFW"},
+					ScaleSettings: computev1alpha.HorizontalScaleSettings{
+						MinReplicas: 1,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tweak := range tweaks {
+		tweak(workload)
+	}
+
+	return workload
+}
+
+// makeInstanceTypes returns the instance types available to workloads in
+// tests, including an instance type which supports customization.
+func makeInstanceTypes() []computev1alpha.InstanceType {
+	return []computev1alpha.InstanceType{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "datumcloud.d1-standard-2",
+			},
+			Spec: computev1alpha.InstanceTypeSpec{
+				Resources: k8scorev1.ResourceList{
+					k8scorev1.ResourceCPU:    resource.MustParse("2"),
+					k8scorev1.ResourceMemory: resource.MustParse("2Gi"),
+				},
+				Runtimes: []computev1alpha.InstanceRuntimeType{
+					computev1alpha.SandboxInstanceRuntimeType,
+					computev1alpha.VirtualMachineInstanceRuntimeType,
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "datumcloud.d1-custom",
+			},
+			Spec: computev1alpha.InstanceTypeSpec{
+				Runtimes: []computev1alpha.InstanceRuntimeType{
+					computev1alpha.SandboxInstanceRuntimeType,
+				},
+				Custom: &computev1alpha.CustomInstanceTypeResources{
+					CPU: computev1alpha.ResourceRange{
+						Min:       resource.MustParse("1"),
+						Max:       resource.MustParse("8"),
+						Increment: ptr.To(resource.MustParse("1")),
+					},
+					Memory: computev1alpha.ResourceRange{
+						Min:       resource.MustParse("1Gi"),
+						Max:       resource.MustParse("32Gi"),
+						Increment: ptr.To(resource.MustParse("1Gi")),
+					},
+				},
+			},
+		},
+	}
+}
+
+// MakeVMWorkload returns a VM runtime workload that will pass validation. By
+// default this produces a workload single placement.
+func MakeVMWorkload(name string, tweaks ...Tweak) *computev1alpha.Workload {
+	workload := &computev1alpha.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: computev1alpha.WorkloadSpec{
+			Template: computev1alpha.InstanceTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						computev1alpha.SSHKeysAnnotation: "user:ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILPbDbsv9fgEnam9iJ5b51Na/WieeiKCJRC0+m7fRwPk vscode@42aafaf8293e",
+					},
+				},
+				Spec: computev1alpha.InstanceSpec{
+					NetworkInterfaces: []computev1alpha.InstanceNetworkInterface{
+						{
+							Network: networkingv1alpha.NetworkRef{
+								Name: "default",
+							},
+						},
+					},
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Resources: computev1alpha.InstanceRuntimeResources{
+							InstanceType: "datumcloud/d1-standard-2",
+						},
+						VirtualMachine: &computev1alpha.VirtualMachineRuntime{
+							VolumeAttachments: []computev1alpha.VolumeAttachment{
+								{
+									Name: "boot",
+								},
+							},
+						},
+					},
+					Volumes: []computev1alpha.InstanceVolume{
+						{
+							Name: "boot",
+							VolumeSource: computev1alpha.VolumeSource{
+								Disk: &computev1alpha.DiskTemplateVolumeSource{
+									Template: &computev1alpha.DiskTemplateVolumeSourceTemplate{
+										Spec: computev1alpha.DiskSpec{
+											Type: "pd-standard",
+											Populator: &computev1alpha.DiskPopulator{
+												Image: &computev1alpha.ImageDiskPopulator{
 													Name: "datumcloud/ubuntu-2204-lts",
 												},
 											},
@@ -751,3 +1879,48 @@ func MakeVMWorkload(name string, tweaks ...Tweak) *computev1alpha.Workload {
 
 	return workload
 }
+
+// makeWorkloadGateway returns a gateway with a single TCP listener and route
+// directed at a named port of "http".
+func makeWorkloadGateway() *computev1alpha.WorkloadGateway {
+	return &computev1alpha.WorkloadGateway{
+		Template: computev1alpha.WorkloadGatewayTemplate{
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: "datum-external-global-proxy",
+				Listeners: []gatewayv1.Listener{
+					{
+						Name:     "tcp",
+						Port:     80,
+						Protocol: gatewayv1.TCPProtocolType,
+					},
+				},
+			},
+		},
+		TCPRoutes: []computev1alpha.WorkloadGatewayTCPRouteSpec{
+			{
+				Name: "http",
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Name:        computev1alpha.WorkloadGatewayParentRefName,
+							SectionName: ptr.To(gatewayv1.SectionName("tcp")),
+						},
+					},
+				},
+				Rules: []computev1alpha.WorkloadGatewayTCPRouteRule{
+					{
+						BackendRefs: []gatewayv1.BackendRef{
+							{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: ptr.To(gatewayv1.Group(computev1alpha.GroupVersion.Group)),
+									Kind:  ptr.To(gatewayv1.Kind(computev1alpha.NamedPortKind)),
+									Name:  "http",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -5,12 +5,15 @@ import (
 
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
 
 	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
@@ -49,27 +52,32 @@ func (r *workloadWebhook) Default(ctx context.Context, obj runtime.Object) error
 	if !ok {
 		return fmt.Errorf("unexpected type %T", obj)
 	}
-	_ = workload
 
-	// // TODO(jreese) review and test gateway defaulting / logic
-	// if gw := workload.Spec.Gateway; gw != nil {
-	// 	for i, tcpRoute := range gw.TCPRoutes {
-	// 		for j := range tcpRoute.ParentRefs {
-	// 			workload.Spec.Gateway.TCPRoutes[i].ParentRefs[j].Name = "workload-gateway"
-	// 		}
-
-	// 		for j := range tcpRoute.Rules {
-	// 			for k := range tcpRoute.Rules[j].BackendRefs {
-	// 				// TODO(jreese) think about this Kind more
-	// 				kind := gatewayv1.Kind("NamedPort")
-	// 				workload.Spec.Gateway.TCPRoutes[i].Rules[j].
-	// 					BackendRefs[k].Kind = &kind
-	// 			}
-	// 		}
-	// 	}
-	// }
-
-	// TODO(user): fill in your defaulting logic.
+	if gw := workload.Spec.Gateway; gw != nil {
+		for i, tcpRoute := range gw.TCPRoutes {
+			// Routes may only attach to the gateway created for the workload.
+			for j := range tcpRoute.ParentRefs {
+				parentRef := &workload.Spec.Gateway.TCPRoutes[i].ParentRefs[j]
+				if parentRef.Name == "" {
+					parentRef.Name = computev1alpha.WorkloadGatewayParentRefName
+				}
+			}
+
+			// Backends are always named ports on the workload's instances.
+			for j := range tcpRoute.Rules {
+				for k := range tcpRoute.Rules[j].BackendRefs {
+					backendRef := &workload.Spec.Gateway.TCPRoutes[i].Rules[j].BackendRefs[k]
+					if backendRef.Group == nil {
+						backendRef.Group = ptr.To(gatewayv1.Group(computev1alpha.GroupVersion.Group))
+					}
+					if backendRef.Kind == nil {
+						backendRef.Kind = ptr.To(gatewayv1.Kind(computev1alpha.NamedPortKind))
+					}
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -83,69 +91,106 @@ func (r *workloadWebhook) ValidateCreate(ctx context.Context, obj runtime.Object
 
 	clusterName := computewebhook.ClusterNameFromContext(ctx)
 
-	cluster, err := r.mgr.GetCluster(ctx, clusterName)
+	logger := logf.FromContext(ctx).WithValues("cluster", clusterName)
+	logger.Info("Validating Workload Create", "name", workload.GetName(), "cluster", clusterName)
+
+	opts, err := r.getValidationOptions(ctx, clusterName, workload)
 	if err != nil {
 		return nil, err
 	}
-	clusterClient := cluster.GetClient()
+
+	if errs := validation.ValidateWorkloadCreate(workload, opts); len(errs) > 0 {
+		return nil, errors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), workload.Name, errs)
+	}
+
+	return nil, nil
+}
+
+func (r *workloadWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldworkload, ok := oldObj.(*computev1alpha.Workload)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", oldObj)
+	}
+
+	newworkload, ok := newObj.(*computev1alpha.Workload)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", newObj)
+	}
+
+	// Workloads being deleted, such as when the controller removes its
+	// finalizer, and updates which leave the spec unchanged are not validated
+	// again, as the locations and instance types the spec refers to may have
+	// since been removed.
+	if !newworkload.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(oldworkload.Spec, newworkload.Spec) {
+		return nil, nil
+	}
+
+	clusterName := computewebhook.ClusterNameFromContext(ctx)
 
 	logger := logf.FromContext(ctx).WithValues("cluster", clusterName)
-	logger.Info("Validating Workload Create", "name", workload.GetName(), "cluster", clusterName)
+	logger.Info("Validating Workload Update", "name", newworkload.GetName(), "cluster", clusterName)
 
-	req, err := admission.RequestFromContext(ctx)
+	opts, err := r.getValidationOptions(ctx, clusterName, newworkload)
 	if err != nil {
 		return nil, err
 	}
 
+	if errs := validation.ValidateWorkloadUpdate(newworkload, oldworkload, opts); len(errs) > 0 {
+		return nil, errors.NewInvalid(newObj.GetObjectKind().GroupVersionKind().GroupKind(), newworkload.Name, errs)
+	}
+
+	return nil, nil
+}
+
+// getValidationOptions returns the options used to validate the workload,
+// including the city codes of locations and the instance types declared in
+// the workload's cluster.
+func (r *workloadWebhook) getValidationOptions(
+	ctx context.Context,
+	clusterName string,
+	workload *computev1alpha.Workload,
+) (validation.WorkloadValidationOptions, error) {
+	cluster, err := r.mgr.GetCluster(ctx, clusterName)
+	if err != nil {
+		return validation.WorkloadValidationOptions{}, err
+	}
+	clusterClient := cluster.GetClient()
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return validation.WorkloadValidationOptions{}, err
+	}
+
 	// TODO(jreese) validate caller access to individual locations, consider what
 	// that means for the scheduling phase, since there would not currently be
 	// sufficient context to know who created the workload and what locations
 	// are valid candidates based on that. Maybe an annotation, or spec field?
 	var locations networkingv1alpha.LocationList
 	if err := clusterClient.List(ctx, &locations); err != nil {
-		return nil, fmt.Errorf("failed to list locations: %w", err)
+		return validation.WorkloadValidationOptions{}, fmt.Errorf("failed to list locations: %w", err)
 	}
 
 	validCityCodes := sets.Set[string]{}
 	for _, location := range locations.Items {
-		cityCode, ok := location.Spec.Topology["topology.datum.net/city-code"]
+		cityCode, ok := location.Spec.Topology[computev1alpha.CityCodeTopologyKey]
 		if ok {
 			validCityCodes.Insert(cityCode)
 		}
 	}
 
-	opts := validation.WorkloadValidationOptions{
+	var instanceTypes computev1alpha.InstanceTypeList
+	if err := clusterClient.List(ctx, &instanceTypes); err != nil {
+		return validation.WorkloadValidationOptions{}, fmt.Errorf("failed to list instance types: %w", err)
+	}
+
+	return validation.WorkloadValidationOptions{
 		Context:          ctx,
 		Client:           clusterClient,
 		AdmissionRequest: req,
 		Workload:         workload,
 		ValidCityCodes:   sets.List(validCityCodes),
-	}
-
-	if errs := validation.ValidateWorkloadCreate(workload, opts); len(errs) > 0 {
-		return nil, errors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), workload.Name, errs)
-	}
-
-	return nil, nil
-}
-
-func (r *workloadWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	oldworkload, ok := oldObj.(*computev1alpha.Workload)
-	if !ok {
-		return nil, fmt.Errorf("unexpected type %T", oldObj)
-	}
-
-	_ = oldworkload
-
-	newworkload, ok := newObj.(*computev1alpha.Workload)
-	if !ok {
-		return nil, fmt.Errorf("unexpected type %T", newObj)
-	}
-
-	_ = newworkload
-
-	// TODO(user): fill in your validation logic upon object update.
-	return nil, nil
+		InstanceTypes:    instanceTypes.Items,
+	}, nil
 }
 
 func (r *workloadWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
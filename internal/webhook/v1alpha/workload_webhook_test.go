@@ -0,0 +1,335 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	mcmanager "sigs.k8s.io/multicluster-runtime/pkg/manager"
+
+	networkingv1alpha "go.datum.net/network-services-operator/api/v1alpha"
+	computev1alpha "go.datum.net/workload-operator/api/v1alpha"
+)
+
+func TestValidateUpdate(t *testing.T) {
+	// staleReferences refers to a location and instance type which have been
+	// removed since the workload was created.
+	staleReferences := func(w *computev1alpha.Workload) {
+		w.Spec.Template.Spec.Runtime.Resources.InstanceType = "datumcloud/unknown"
+		w.Spec.Placements = append(w.Spec.Placements, computev1alpha.WorkloadPlacement{
+			Name:      "placement2",
+			CityCodes: []string{"LHR"},
+			ScaleSettings: computev1alpha.HorizontalScaleSettings{
+				MinReplicas: 1,
+			},
+		})
+	}
+
+	scenarios := map[string]struct {
+		oldTweak      func(*computev1alpha.Workload)
+		tweak         func(*computev1alpha.Workload)
+		expectedField string
+	}{
+		"valid update": {
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.Placements[0].ScaleSettings.MinReplicas = 2
+			},
+		},
+		"invalid rollback revision": {
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.RollbackTo = &computev1alpha.WorkloadRollbackConfig{Revision: -1}
+			},
+			expectedField: "spec.rollbackTo.revision",
+		},
+		"restart requested in the future": {
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.Restart = &computev1alpha.InstanceRestartRequest{
+					RequestedAt: metav1.NewTime(time.Now().Add(time.Hour)),
+				}
+			},
+			expectedField: "spec.restart.requestedAt",
+		},
+		"undeclared instance type": {
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.Template.Spec.Runtime.Resources.InstanceType = "datumcloud/unknown"
+			},
+			expectedField: "spec.template.spec.runtime.resources",
+		},
+		"unchanged spec with stale references": {
+			oldTweak: staleReferences,
+			tweak: func(w *computev1alpha.Workload) {
+				w.Labels = map[string]string{"app": "test"}
+			},
+		},
+		"deleting with stale references": {
+			oldTweak: staleReferences,
+			tweak: func(w *computev1alpha.Workload) {
+				w.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				w.Finalizers = nil
+			},
+		},
+		"rollback cleared with stale references": {
+			oldTweak: func(w *computev1alpha.Workload) {
+				staleReferences(w)
+				w.Spec.RollbackTo = &computev1alpha.WorkloadRollbackConfig{Revision: 1}
+			},
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.RollbackTo = nil
+			},
+		},
+		"unchanged placement with stale city code": {
+			oldTweak: func(w *computev1alpha.Workload) {
+				staleReferences(w)
+				w.Spec.Template.Spec.Runtime.Resources.InstanceType = "datumcloud/d1-standard-2"
+			},
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.Placements[0].ScaleSettings.MinReplicas = 2
+			},
+		},
+		"changed placement with stale city code": {
+			oldTweak: staleReferences,
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.Placements[1].ScaleSettings.MinReplicas = 2
+			},
+			expectedField: "spec.placements[1].cityCodes[0]",
+		},
+		"unknown city code": {
+			tweak: func(w *computev1alpha.Workload) {
+				w.Spec.Placements[0].CityCodes = []string{"LHR"}
+			},
+			expectedField: "spec.placements[0].cityCodes[0]",
+		},
+	}
+
+	webhook := &workloadWebhook{
+		mgr: &testManager{cluster: &testCluster{client: newTestClient()}},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{})
+
+			oldWorkload := newTestWorkload()
+			if scenario.oldTweak != nil {
+				scenario.oldTweak(oldWorkload)
+			}
+			newWorkload := oldWorkload.DeepCopy()
+			scenario.tweak(newWorkload)
+
+			_, err := webhook.ValidateUpdate(ctx, oldWorkload, newWorkload)
+			if scenario.expectedField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.True(t, apierrors.IsInvalid(err), "expected invalid error, got %v", err) {
+				var fields []string
+				for _, cause := range err.(*apierrors.StatusError).Status().Details.Causes {
+					fields = append(fields, cause.Field)
+				}
+				assert.Contains(t, fields, scenario.expectedField)
+			}
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	scenarios := map[string]struct {
+		parentRefName gatewayv1.ObjectName
+		backendRef    gatewayv1.BackendObjectReference
+		expectedName  gatewayv1.ObjectName
+		expectedGroup gatewayv1.Group
+		expectedKind  gatewayv1.Kind
+	}{
+		"unset fields are defaulted": {
+			backendRef: gatewayv1.BackendObjectReference{
+				Name: "http",
+			},
+			expectedName:  computev1alpha.WorkloadGatewayParentRefName,
+			expectedGroup: gatewayv1.Group(computev1alpha.GroupVersion.Group),
+			expectedKind:  computev1alpha.NamedPortKind,
+		},
+		"set fields are preserved": {
+			parentRefName: "other-gateway",
+			backendRef: gatewayv1.BackendObjectReference{
+				Group: ptr.To(gatewayv1.Group("")),
+				Kind:  ptr.To(gatewayv1.Kind("Service")),
+				Name:  "http",
+			},
+			expectedName:  "other-gateway",
+			expectedGroup: "",
+			expectedKind:  "Service",
+		},
+	}
+
+	webhook := &workloadWebhook{}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			workload := newTestWorkload()
+			workload.Spec.Gateway = &computev1alpha.WorkloadGateway{
+				TCPRoutes: []computev1alpha.WorkloadGatewayTCPRouteSpec{
+					{
+						CommonRouteSpec: gatewayv1.CommonRouteSpec{
+							ParentRefs: []gatewayv1.ParentReference{
+								{
+									Name: scenario.parentRefName,
+								},
+							},
+						},
+						Rules: []computev1alpha.WorkloadGatewayTCPRouteRule{
+							{
+								BackendRefs: []gatewayv1.BackendRef{
+									{
+										BackendObjectReference: scenario.backendRef,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			assert.NoError(t, webhook.Default(context.Background(), workload))
+
+			tcpRoute := workload.Spec.Gateway.TCPRoutes[0]
+			assert.Equal(t, scenario.expectedName, tcpRoute.ParentRefs[0].Name)
+
+			backendRef := tcpRoute.Rules[0].BackendRefs[0]
+			assert.Equal(t, scenario.expectedGroup, ptr.Deref(backendRef.Group, "unset"))
+			assert.Equal(t, scenario.expectedKind, ptr.Deref(backendRef.Kind, "unset"))
+		})
+	}
+}
+
+type testManager struct {
+	mcmanager.Manager
+	cluster cluster.Cluster
+}
+
+func (m *testManager) GetCluster(ctx context.Context, clusterName string) (cluster.Cluster, error) {
+	return m.cluster, nil
+}
+
+type testCluster struct {
+	cluster.Cluster
+	client client.Client
+}
+
+func (c *testCluster) GetClient() client.Client {
+	return c.client
+}
+
+func newTestClient() client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(computev1alpha.AddToScheme(scheme))
+	utilruntime.Must(networkingv1alpha.AddToScheme(scheme))
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if sar, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+					// The fake client only allow a resource to be created without a name
+					sar.GenerateName = "sar-"
+					sar.Status.Allowed = true
+				}
+				return client.Create(ctx, obj, opts...)
+			},
+		}).
+		WithObjects(
+			&networkingv1alpha.Location{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "dfw-1",
+				},
+				Spec: networkingv1alpha.LocationSpec{
+					Topology: map[string]string{
+						computev1alpha.CityCodeTopologyKey: "DFW",
+					},
+				},
+			},
+			&networkingv1alpha.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "default",
+				},
+			},
+			&computev1alpha.InstanceType{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "datumcloud.d1-standard-2",
+				},
+				Spec: computev1alpha.InstanceTypeSpec{
+					Resources: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+					Runtimes: []computev1alpha.InstanceRuntimeType{
+						computev1alpha.SandboxInstanceRuntimeType,
+					},
+				},
+			},
+		).
+		Build()
+}
+
+func newTestWorkload() *computev1alpha.Workload {
+	return &computev1alpha.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test",
+		},
+		Spec: computev1alpha.WorkloadSpec{
+			Template: computev1alpha.InstanceTemplateSpec{
+				Spec: computev1alpha.InstanceSpec{
+					NetworkInterfaces: []computev1alpha.InstanceNetworkInterface{
+						{
+							Network: networkingv1alpha.NetworkRef{
+								Name: "default",
+							},
+						},
+					},
+					Runtime: computev1alpha.InstanceRuntimeSpec{
+						Resources: computev1alpha.InstanceRuntimeResources{
+							InstanceType: "datumcloud/d1-standard-2",
+						},
+						Sandbox: &computev1alpha.SandboxRuntime{
+							Containers: []computev1alpha.SandboxContainer{
+								{
+									Name:  "container1",
+									Image: "registry.tld/image:tag",
+								},
+							},
+						},
+					},
+				},
+			},
+			Placements: []computev1alpha.WorkloadPlacement{
+				{
+					Name:      "placement1",
+					CityCodes: []string{"DFW"},
+					ScaleSettings: computev1alpha.HorizontalScaleSettings{
+						MinReplicas: 1,
+					},
+				},
+			},
+		},
+	}
+}